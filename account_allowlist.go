@@ -0,0 +1,62 @@
+package poa
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// bech32Charset are the characters of the data part of a bech32 address.
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// ValidateAddressPrefix checks the prefix is the start of a bech32 address: a human readable part, the "1" separator
+// and optionally the first characters of the data part (e.g. cosmos1 or cosmos1qqq).
+func ValidateAddressPrefix(prefix string) error {
+	sep := strings.LastIndex(prefix, "1")
+	if sep < 1 {
+		return fmt.Errorf("address prefix %q must contain a human readable part and the separator", prefix)
+	}
+
+	for _, c := range prefix[:sep] {
+		if c < 33 || c > 126 || (c >= 'A' && c <= 'Z') {
+			return fmt.Errorf("address prefix %q has an invalid human readable part", prefix)
+		}
+	}
+
+	for _, c := range prefix[sep+1:] {
+		if !strings.ContainsRune(bech32Charset, c) {
+			return fmt.Errorf("address prefix %q has an invalid bech32 character %q", prefix, c)
+		}
+	}
+
+	return nil
+}
+
+// validateAccountAllowlist checks the accounts and prefixes of the allowlist are valid and unique.
+func validateAccountAllowlist(accounts, prefixes []string) error {
+	seen := make(map[string]bool, len(accounts)+len(prefixes))
+	for _, account := range accounts {
+		if _, err := sdk.AccAddressFromBech32(account); err != nil {
+			return fmt.Errorf("invalid allowed account %s: %w", account, err)
+		}
+
+		if seen[account] {
+			return fmt.Errorf("duplicate allowed account found in genesis state: %s", account)
+		}
+		seen[account] = true
+	}
+
+	for _, prefix := range prefixes {
+		if err := ValidateAddressPrefix(prefix); err != nil {
+			return err
+		}
+
+		if seen[prefix] {
+			return fmt.Errorf("duplicate allowed prefix found in genesis state: %s", prefix)
+		}
+		seen[prefix] = true
+	}
+
+	return nil
+}
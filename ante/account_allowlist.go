@@ -0,0 +1,53 @@
+package poaante
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// AccountAllowlistKeeper validates the signers of a tx against the POA account allowlist.
+type AccountAllowlistKeeper interface {
+	ValidateTxSigners(ctx context.Context, msgs []sdk.Msg, signers [][]byte) error
+}
+
+// AccountAllowlistDecorator rejects transactions signed by an account that is not in the POA account allowlist, when
+// the allowlist is enabled in the POA params. It should be placed before the x/auth DeductFeeDecorator, so that the
+// transactions of the other accounts are rejected before any fee is charged.
+type AccountAllowlistDecorator struct {
+	keeper AccountAllowlistKeeper
+}
+
+func NewPOAAccountAllowlistDecorator(k AccountAllowlistKeeper) AccountAllowlistDecorator {
+	return AccountAllowlistDecorator{
+		keeper: k,
+	}
+}
+
+// AnteHandle performs an AnteHandler check that returns an error if a signer of the tx is not allowed.
+func (aad AccountAllowlistDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if isGenesisTx(ctx) {
+		// allow GenTx to pass
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	if err := aad.keeper.ValidateTxSigners(ctx, tx.GetMsgs(), signers); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
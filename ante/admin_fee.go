@@ -0,0 +1,57 @@
+package poaante
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AdminTxPriority is the mempool priority of the fee exempted POA admin transactions.
+const AdminTxPriority int64 = math.MaxInt64
+
+// AdminTxKeeper checks if the messages of a tx are only POA messages sent by the admin.
+type AdminTxKeeper interface {
+	IsAdminTx(ctx context.Context, msgs []sdk.Msg) bool
+}
+
+// AdminFeeDecorator exempts the POA admin transactions from the minimum gas prices of the validators and gives them
+// the highest mempool priority, so that they land when the network is congested. Only transactions of POA messages
+// sent by the admin, with a gas limit up to MaxGas, are exempted. It must be placed before the x/auth
+// DeductFeeDecorator, the fees set in the transaction are still deducted.
+type AdminFeeDecorator struct {
+	keeper AdminTxKeeper
+
+	// the maximum gas limit of an exempted transaction.
+	MaxGas uint64
+}
+
+func NewPOAAdminFeeDecorator(k AdminTxKeeper, maxGas uint64) AdminFeeDecorator {
+	if maxGas == 0 {
+		panic(fmt.Sprintf("NewPOAAdminFeeDecorator: maxGas must be positive, got %d", maxGas))
+	}
+
+	return AdminFeeDecorator{
+		keeper: k,
+		MaxGas: maxGas,
+	}
+}
+
+// AnteHandle performs an AnteHandler that waives the minimum gas prices check of the admin transactions and sets their
+// priority.
+func (afd AdminFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() > afd.MaxGas || !afd.keeper.IsAdminTx(ctx, tx.GetMsgs()) {
+		return next(ctx, tx, simulate)
+	}
+
+	minGasPrices := ctx.MinGasPrices()
+
+	newCtx, err := next(ctx.WithMinGasPrices(sdk.DecCoins{}), tx, simulate)
+	if err != nil {
+		return newCtx, err
+	}
+
+	return newCtx.WithMinGasPrices(minGasPrices).WithPriority(AdminTxPriority), nil
+}
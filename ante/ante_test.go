@@ -1,18 +1,27 @@
 package poaante
 
 import (
+	"context"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	protov2 "google.golang.org/protobuf/proto"
 
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/group"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"cosmossdk.io/math"
@@ -118,6 +127,120 @@ func TestAnteCommissionRanges(t *testing.T) {
 	}
 }
 
+func TestAnteCommissionLimitEdits(t *testing.T) {
+	mcl := NewCommissionLimitDecorator(true, math.LegacyMustNewDecFromStr("0.10"), math.LegacyMustNewDecFromStr("0.50"))
+	rate := math.LegacyMustNewDecFromStr("0.60")
+
+	// an edit without a commission rate does not change the commission.
+	_, err := mcl.AnteHandle(sdk.Context{}, NewMockTx(&stakingtypes.MsgEditValidator{}), false, EmptyAnte)
+	require.NoError(t, err)
+
+	// every message is checked, not only the first one.
+	_, err = mcl.AnteHandle(sdk.Context{}, NewMockTx(
+		&stakingtypes.MsgEditValidator{},
+		&stakingtypes.MsgEditValidator{CommissionRate: &rate},
+	), false, EmptyAnte)
+	require.Error(t, err)
+}
+
+// mockCommissionLimitKeeper checks the commission rates against the limits, like a POA keeper with the commission
+// limits in its params.
+type mockCommissionLimitKeeper struct {
+	limits poa.CommissionLimits
+}
+
+func (k mockCommissionLimitKeeper) ValidateCommissionMsg(_ context.Context, msg sdk.Msg) error {
+	switch msg := msg.(type) {
+	case *poa.MsgCreateValidator:
+		return k.limits.CheckRates(msg.Commission.Rate, msg.Commission.MaxRate, msg.Commission.MaxChangeRate)
+	case *stakingtypes.MsgEditValidator:
+		if msg.CommissionRate == nil {
+			return nil
+		}
+
+		return k.limits.CheckRate(*msg.CommissionRate)
+	}
+
+	return nil
+}
+
+func TestAntePOACommissionLimit(t *testing.T) {
+	limits := poa.DefaultCommissionLimits()
+	limits.Rate = poa.NewDecRange(math.LegacyMustNewDecFromStr("0.10"), math.LegacyMustNewDecFromStr("0.50"))
+	limits.MaxRate = poa.NewDecRange(math.LegacyMustNewDecFromStr("0.20"), math.LegacyMustNewDecFromStr("0.80"))
+	limits.MaxChangeRate = poa.NewDecRange(math.LegacyZeroDec(), math.LegacyMustNewDecFromStr("0.05"))
+
+	mcl := NewPOACommissionLimitDecorator(mockCommissionLimitKeeper{limits: limits})
+
+	commission := func(rate, maxRate, maxChangeRate string) poa.CommissionRates {
+		return poa.CommissionRates{
+			Rate:          math.LegacyMustNewDecFromStr(rate),
+			MaxRate:       math.LegacyMustNewDecFromStr(maxRate),
+			MaxChangeRate: math.LegacyMustNewDecFromStr(maxChangeRate),
+		}
+	}
+
+	badRate := math.LegacyMustNewDecFromStr("0.60")
+	nested, err := types.NewAnyWithValue(&stakingtypes.MsgEditValidator{CommissionRate: &badRate})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		msgs []sdk.Msg
+		err  error
+	}{
+		{
+			name: "create validator within the limits",
+			msgs: []sdk.Msg{&poa.MsgCreateValidator{Commission: commission("0.10", "0.20", "0.01")}},
+		},
+		{
+			name: "fail: max rate above the limit",
+			msgs: []sdk.Msg{&poa.MsgCreateValidator{Commission: commission("0.10", "0.90", "0.01")}},
+			err:  poa.ErrCommissionOutOfRange,
+		},
+		{
+			name: "fail: max change rate above the limit",
+			msgs: []sdk.Msg{&poa.MsgCreateValidator{Commission: commission("0.10", "0.20", "0.10")}},
+			err:  poa.ErrCommissionOutOfRange,
+		},
+		{
+			name: "edit validator without a commission change",
+			msgs: []sdk.Msg{&stakingtypes.MsgEditValidator{}},
+		},
+		{
+			name: "fail: second message out of the limits",
+			msgs: []sdk.Msg{
+				&stakingtypes.MsgEditValidator{},
+				&stakingtypes.MsgEditValidator{CommissionRate: &badRate},
+			},
+			err: poa.ErrCommissionOutOfRange,
+		},
+		{
+			name: "fail: nested edit out of the limits",
+			msgs: []sdk.Msg{&authz.MsgExec{Msgs: []*types.Any{nested}}},
+			err:  poa.ErrCommissionOutOfRange,
+		},
+		{
+			name: "fail: edit out of the limits in a group proposal",
+			msgs: []sdk.Msg{&group.MsgSubmitProposal{Messages: []*types.Any{nested}, Exec: group.Exec_EXEC_TRY}},
+			err:  poa.ErrCommissionOutOfRange,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			// gentxs are checked too.
+			_, err := mcl.AnteHandle(sdk.Context{}, NewMockTx(tc.msgs...), false, EmptyAnte)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestAnteNested(t *testing.T) {
 	ctx := sdk.Context{}
 	ctx = setBlockHeader(ctx, 2)
@@ -262,6 +385,146 @@ func TestAnteStakingFilter(t *testing.T) {
 	}
 }
 
+// mockHybridKeeper allows delegations to a single validator, like a POA keeper in hybrid mode.
+type mockHybridKeeper struct {
+	authorized string
+}
+
+func (k mockHybridKeeper) ValidateHybridStakingMsg(_ context.Context, msg sdk.Msg) error {
+	if msg, ok := msg.(*stakingtypes.MsgDelegate); ok && msg.ValidatorAddress != k.authorized {
+		return poa.ErrValidatorNotAuthorized
+	}
+
+	return nil
+}
+
+func TestAnteHybridStakingFilter(t *testing.T) {
+	ctx := setBlockHeader(sdk.Context{}, 2)
+	sf := NewPOAStakingFilterDecorator(mockHybridKeeper{authorized: "authorized"})
+
+	testCases := []struct {
+		name string
+		msg  sdk.Msg
+		err  error
+	}{
+		{
+			name: "delegate to authorized validator",
+			msg:  &stakingtypes.MsgDelegate{ValidatorAddress: "authorized"},
+		},
+		{
+			name: "fail: delegate to unauthorized validator",
+			msg:  &stakingtypes.MsgDelegate{ValidatorAddress: "unauthorized"},
+			err:  poa.ErrValidatorNotAuthorized,
+		},
+		{
+			name: "undelegate",
+			msg:  &stakingtypes.MsgUndelegate{},
+		},
+		{
+			name: "fail: staking create validator",
+			msg:  &stakingtypes.MsgCreateValidator{},
+			err:  poa.ErrStakingActionNotAllowed,
+		},
+		{
+			name: "fail: staking update params",
+			msg:  &stakingtypes.MsgUpdateParams{},
+			err:  poa.ErrStakingActionNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := sf.AnteHandle(ctx, NewMockTx(tc.msg), false, EmptyAnte)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// mockMsgFilterKeeper blocks the message types, like a POA keeper with blocked message types in its params.
+type mockMsgFilterKeeper struct {
+	blocked []string
+}
+
+func (k mockMsgFilterKeeper) ValidateMsgAllowed(_ context.Context, msg sdk.Msg) error {
+	for _, typeURL := range k.blocked {
+		if sdk.MsgTypeURL(msg) == typeURL {
+			return poa.ErrMsgTypeBlocked
+		}
+	}
+
+	return nil
+}
+
+func TestAnteMsgFilter(t *testing.T) {
+	mf := NewPOAMsgFilterDecorator(mockMsgFilterKeeper{blocked: []string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})}})
+
+	genesisCtx := sdk.Context{}
+	blockCtx := sdk.Context{}.WithBlockHeader(cmtproto.Header{Height: 1, ProposerAddress: []byte("proposer")})
+	checkTxCtx := sdk.Context{}.WithIsCheckTx(true)
+
+	nested, err := types.NewAnyWithValue(&stakingtypes.MsgDelegate{})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		ctx  sdk.Context
+		msg  sdk.Msg
+		err  error
+	}{
+		{
+			name: "allow GenTx to pass",
+			ctx:  genesisCtx,
+			msg:  &stakingtypes.MsgDelegate{},
+		},
+		{
+			name: "fail: blocked message",
+			ctx:  blockCtx,
+			msg:  &stakingtypes.MsgDelegate{},
+			err:  poa.ErrMsgTypeBlocked,
+		},
+		{
+			name: "fail: blocked message in check tx",
+			ctx:  checkTxCtx,
+			msg:  &stakingtypes.MsgDelegate{},
+			err:  poa.ErrMsgTypeBlocked,
+		},
+		{
+			name: "fail: nested blocked message",
+			ctx:  blockCtx,
+			msg:  &authz.MsgExec{Msgs: []*types.Any{nested}},
+			err:  poa.ErrMsgTypeBlocked,
+		},
+		{
+			name: "fail: blocked message in a gov proposal",
+			ctx:  blockCtx,
+			msg:  &govv1.MsgSubmitProposal{Messages: []*types.Any{nested}},
+			err:  poa.ErrMsgTypeBlocked,
+		},
+		{
+			name: "allowed message",
+			ctx:  blockCtx,
+			msg:  &stakingtypes.MsgUndelegate{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := mf.AnteHandle(tc.ctx, NewMockTx(tc.msg), false, EmptyAnte)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestAnteDisableWithdrawRewards(t *testing.T) {
 	ctx := sdk.Context{}
 	dwr := NewPOADisableWithdrawDelegatorRewards()
@@ -293,6 +556,231 @@ func TestAnteDisableWithdrawRewards(t *testing.T) {
 	}
 }
 
+// mockAdminTxKeeper treats the txs of POA messages as admin txs.
+type mockAdminTxKeeper struct{}
+
+func (mockAdminTxKeeper) IsAdminTx(_ context.Context, msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		if !poa.IsPOAMsgTypeURL(sdk.MsgTypeURL(msg)) {
+			return false
+		}
+	}
+
+	return len(msgs) > 0
+}
+
+func TestAnteAdminFee(t *testing.T) {
+	afd := NewPOAAdminFeeDecorator(mockAdminTxKeeper{}, 200_000)
+
+	require.Panics(t, func() {
+		NewPOAAdminFeeDecorator(mockAdminTxKeeper{}, 0)
+	})
+
+	// deductFee rejects txs without fees when the validator has minimum gas prices, like the x/auth DeductFeeDecorator.
+	deductFee := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		if !ctx.MinGasPrices().IsZero() && tx.(sdk.FeeTx).GetFee().IsZero() {
+			return ctx, fmt.Errorf("insufficient fees")
+		}
+
+		return ctx.WithPriority(1), nil
+	}
+
+	ctx := sdk.Context{}.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyOneDec())))
+
+	testCases := []struct {
+		name     string
+		tx       sdk.Tx
+		exempted bool
+	}{
+		{
+			name:     "admin tx",
+			tx:       NewMockFeeTx(100_000, &poa.MsgSetPower{}, &poa.MsgRemoveValidator{}),
+			exempted: true,
+		},
+		{
+			name: "admin tx over the gas cap",
+			tx:   NewMockFeeTx(200_001, &poa.MsgSetPower{}),
+		},
+		{
+			name: "tx with a non POA message",
+			tx:   NewMockFeeTx(100_000, &poa.MsgSetPower{}, &stakingtypes.MsgEditValidator{}),
+		},
+		{
+			name: "tx without fee",
+			tx:   NewMockTx(&poa.MsgSetPower{}),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if _, ok := tc.tx.(sdk.FeeTx); !ok {
+				_, err := afd.AnteHandle(ctx, tc.tx, false, EmptyAnte)
+				require.NoError(t, err)
+				return
+			}
+
+			newCtx, err := afd.AnteHandle(ctx, tc.tx, false, deductFee)
+			if !tc.exempted {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, AdminTxPriority, newCtx.Priority())
+			require.Equal(t, ctx.MinGasPrices(), newCtx.MinGasPrices())
+		})
+	}
+}
+
+// mockMaintenanceKeeper is in maintenance mode, allowing the POA messages and the allowed message types.
+type mockMaintenanceKeeper struct {
+	allowed []string
+}
+
+func (k mockMaintenanceKeeper) ValidateMaintenanceMsg(_ context.Context, msg sdk.Msg) error {
+	typeURL := sdk.MsgTypeURL(msg)
+	if poa.IsPOAMsgTypeURL(typeURL) || slices.Contains(k.allowed, typeURL) {
+		return nil
+	}
+
+	return poa.ErrMaintenanceMode
+}
+
+func TestAnteMaintenance(t *testing.T) {
+	md := NewPOAMaintenanceDecorator(mockMaintenanceKeeper{allowed: []string{sdk.MsgTypeURL(&authz.MsgExec{})}})
+
+	genesisCtx := sdk.Context{}
+	blockCtx := sdk.Context{}.WithBlockHeader(cmtproto.Header{Height: 1, ProposerAddress: []byte("proposer")})
+
+	testCases := []struct {
+		name string
+		ctx  sdk.Context
+		msgs []sdk.Msg
+		err  error
+	}{
+		{
+			name: "allow GenTx to pass",
+			ctx:  genesisCtx,
+			msgs: []sdk.Msg{&stakingtypes.MsgDelegate{}},
+		},
+		{
+			name: "admin messages",
+			ctx:  blockCtx,
+			msgs: []sdk.Msg{&poa.MsgSetPower{}, &poa.MsgRemoveValidator{}},
+		},
+		{
+			name: "fail: user message",
+			ctx:  blockCtx,
+			msgs: []sdk.Msg{&poa.MsgSetPower{}, &stakingtypes.MsgDelegate{}},
+			err:  poa.ErrMaintenanceMode,
+		},
+		{
+			name: "allowed wrapper of an admin message",
+			ctx:  blockCtx,
+			msgs: []sdk.Msg{&authz.MsgExec{Msgs: newAnys(t, &poa.MsgSetPower{})}},
+		},
+		{
+			name: "fail: allowed wrapper of a user message",
+			ctx:  blockCtx,
+			msgs: []sdk.Msg{&authz.MsgExec{Msgs: newAnys(t, &stakingtypes.MsgDelegate{})}},
+			err:  poa.ErrMaintenanceMode,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := md.AnteHandle(tc.ctx, NewMockTx(tc.msgs...), false, EmptyAnte)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// mockAccountAllowlistKeeper allows the listed signers, and every signer of the txs of POA messages.
+type mockAccountAllowlistKeeper struct {
+	mockAdminTxKeeper
+	allowed []string
+}
+
+func (k mockAccountAllowlistKeeper) ValidateTxSigners(ctx context.Context, msgs []sdk.Msg, signers [][]byte) error {
+	if k.IsAdminTx(ctx, msgs) {
+		return nil
+	}
+
+	for _, signer := range signers {
+		if !slices.Contains(k.allowed, string(signer)) {
+			return poa.ErrAccountNotAllowed
+		}
+	}
+
+	return nil
+}
+
+func TestAnteAccountAllowlist(t *testing.T) {
+	aad := NewPOAAccountAllowlistDecorator(mockAccountAllowlistKeeper{allowed: []string{"alice"}})
+
+	genesisCtx := sdk.Context{}
+	blockCtx := sdk.Context{}.WithBlockHeader(cmtproto.Header{Height: 1, ProposerAddress: []byte("proposer")})
+
+	testCases := []struct {
+		name string
+		ctx  sdk.Context
+		tx   sdk.Tx
+		err  error
+	}{
+		{
+			name: "allow GenTx to pass",
+			ctx:  genesisCtx,
+			tx:   NewMockSigTx([][]byte{[]byte("bob")}, &stakingtypes.MsgDelegate{}),
+		},
+		{
+			name: "allowed signer",
+			ctx:  blockCtx,
+			tx:   NewMockSigTx([][]byte{[]byte("alice")}, &stakingtypes.MsgDelegate{}),
+		},
+		{
+			name: "fail: signer not allowed",
+			ctx:  blockCtx,
+			tx:   NewMockSigTx([][]byte{[]byte("bob")}, &stakingtypes.MsgDelegate{}),
+			err:  poa.ErrAccountNotAllowed,
+		},
+		{
+			name: "fail: one of the signers not allowed",
+			ctx:  blockCtx,
+			tx:   NewMockSigTx([][]byte{[]byte("alice"), []byte("bob")}, &stakingtypes.MsgDelegate{}),
+			err:  poa.ErrAccountNotAllowed,
+		},
+		{
+			name: "admin tx",
+			ctx:  blockCtx,
+			tx:   NewMockSigTx([][]byte{[]byte("bob")}, &poa.MsgUpdateAccountAllowlist{}),
+		},
+		{
+			name: "fail: tx without signers",
+			ctx:  blockCtx,
+			tx:   NewMockTx(&stakingtypes.MsgDelegate{}),
+			err:  sdkerrors.ErrTxDecode,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := aad.AnteHandle(tc.ctx, tc.tx, false, EmptyAnte)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func setBlockHeader(ctx sdk.Context, height int64) sdk.Context {
 	h := ctx.BlockHeader()
 	h.Height = height
@@ -317,3 +805,57 @@ func (tx MockTx) GetMsgs() []sdk.Msg {
 func (tx MockTx) GetMsgsV2() ([]protov2.Message, error) {
 	return nil, nil
 }
+
+// MockFeeTx is a MockTx with a gas limit and no fees.
+type MockFeeTx struct {
+	MockTx
+	gas uint64
+}
+
+func NewMockFeeTx(gas uint64, msgs ...sdk.Msg) MockFeeTx {
+	return MockFeeTx{
+		MockTx: NewMockTx(msgs...),
+		gas:    gas,
+	}
+}
+
+func (tx MockFeeTx) GetGas() uint64 {
+	return tx.gas
+}
+
+func (tx MockFeeTx) GetFee() sdk.Coins {
+	return sdk.Coins{}
+}
+
+func (tx MockFeeTx) FeePayer() []byte {
+	return nil
+}
+
+func (tx MockFeeTx) FeeGranter() []byte {
+	return nil
+}
+
+// MockSigTx is a MockTx with signers and no signatures.
+type MockSigTx struct {
+	MockTx
+	signers [][]byte
+}
+
+func NewMockSigTx(signers [][]byte, msgs ...sdk.Msg) MockSigTx {
+	return MockSigTx{
+		MockTx:  NewMockTx(msgs...),
+		signers: signers,
+	}
+}
+
+func (tx MockSigTx) GetSigners() ([][]byte, error) {
+	return tx.signers, nil
+}
+
+func (tx MockSigTx) GetPubKeys() ([]cryptotypes.PubKey, error) {
+	return nil, nil
+}
+
+func (tx MockSigTx) GetSignaturesV2() ([]signing.SignatureV2, error) {
+	return nil, nil
+}
@@ -1,10 +1,10 @@
 package poaante
 
 import (
+	"context"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/authz"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"cosmossdk.io/math"
@@ -12,6 +12,11 @@ import (
 	"github.com/strangelove-ventures/poa"
 )
 
+// CommissionLimitKeeper validates the commission rates of messages against the commission limits of the POA params.
+type CommissionLimitKeeper interface {
+	ValidateCommissionMsg(ctx context.Context, msg sdk.Msg) error
+}
+
 // CommissionLimitDecorator limits commission rates for validators between 2 ranges.
 // if both ranges are the same, the rate change must only be the value both.
 type CommissionLimitDecorator struct {
@@ -21,8 +26,12 @@ type CommissionLimitDecorator struct {
 	// the validator's set commission rate.
 	RateFloor math.LegacyDec
 	RateCeil  math.LegacyDec
+
+	// if set, the commission limits of the POA params are used instead of the rate floor and ceil.
+	keeper CommissionLimitKeeper
 }
 
+// Deprecated: use NewPOACommissionLimitDecorator, which checks all commission rates against the POA params.
 func NewCommissionLimitDecorator(doGenTxRateValidation bool, rateFloor, rateCiel math.LegacyDec) CommissionLimitDecorator {
 	if rateFloor.GT(rateCiel) {
 		panic(fmt.Sprintf("NewCommissionLimitDecorator: rateFloor %v is greater than rateCiel %v", rateFloor, rateCiel))
@@ -35,13 +44,23 @@ func NewCommissionLimitDecorator(doGenTxRateValidation bool, rateFloor, rateCiel
 	}
 }
 
+// NewPOACommissionLimitDecorator limits the commission rate, max rate and max change rate of validators to the
+// commission limits of the POA params, which the admin can update. Gentxs are checked as well, the POA
+// CreateValidator message server enforces the same limits.
+func NewPOACommissionLimitDecorator(k CommissionLimitKeeper) CommissionLimitDecorator {
+	return CommissionLimitDecorator{
+		DoGenTxRateValidation: true,
+		keeper:                k,
+	}
+}
+
 // AnteHandle performs an AnteHandler check that returns an error if the tx contains a message that is not within the commission limit.
 func (mcl CommissionLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	if !mcl.DoGenTxRateValidation && ctx.BlockHeight() <= 1 {
 		return next(ctx, tx, simulate)
 	}
 
-	err := mcl.hasInvalidCommissionRange(tx.GetMsgs())
+	err := mcl.hasInvalidCommissionRange(ctx, tx.GetMsgs())
 	if err != nil {
 		return ctx, err
 	}
@@ -49,19 +68,10 @@ func (mcl CommissionLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	return next(ctx, tx, simulate)
 }
 
-func (mcl CommissionLimitDecorator) hasInvalidCommissionRange(msgs []sdk.Msg) error {
-	for _, msg := range msgs {
-		// authz nested message check (recursive)
-		if execMsg, ok := msg.(*authz.MsgExec); ok {
-			msgs, err := execMsg.GetMessages()
-			if err != nil {
-				return err
-			}
-
-			err = mcl.hasInvalidCommissionRange(msgs)
-			if err != nil {
-				return err
-			}
+func (mcl CommissionLimitDecorator) hasInvalidCommissionRange(ctx sdk.Context, msgs []sdk.Msg) error {
+	return WalkMsgs(msgs, func(msg sdk.Msg) error {
+		if mcl.keeper != nil {
+			return mcl.keeper.ValidateCommissionMsg(ctx, msg)
 		}
 
 		switch msg := msg.(type) {
@@ -70,11 +80,16 @@ func (mcl CommissionLimitDecorator) hasInvalidCommissionRange(msgs []sdk.Msg) er
 			return rateCheck(msg.Commission.Rate, mcl.RateFloor, mcl.RateCeil)
 		// Editing the validator through staking (no POA edit)
 		case *stakingtypes.MsgEditValidator:
+			// the commission rate is not changed by the edit.
+			if msg.CommissionRate == nil {
+				return nil
+			}
+
 			return rateCheck(*msg.CommissionRate, mcl.RateFloor, mcl.RateCeil)
 		}
-	}
 
-	return nil
+		return nil
+	})
 }
 
 func rateCheck(source math.LegacyDec, low math.LegacyDec, high math.LegacyDec) error {
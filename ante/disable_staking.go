@@ -1,20 +1,39 @@
 package poaante
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/authz"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/strangelove-ventures/poa"
 )
 
+// HybridStakingKeeper validates the x/staking messages of token holders when the POA hybrid mode is enabled.
+type HybridStakingKeeper interface {
+	ValidateHybridStakingMsg(ctx context.Context, msg sdk.Msg) error
+}
+
 type MsgStakingFilterDecorator struct {
+	// if set, delegation messages are validated by the keeper instead of being blocked.
+	hybridKeeper HybridStakingKeeper
 }
 
+// Deprecated: use NewPOAMsgFilterDecorator, which blocks the message types set in the POA params.
 func NewPOADisableStakingDecorator() MsgStakingFilterDecorator {
 	return MsgStakingFilterDecorator{}
 }
 
+// NewPOAStakingFilterDecorator blocks the same messages as NewPOADisableStakingDecorator, except for the delegation
+// messages of token holders to authorized validators when the hybrid mode is enabled in the POA params.
+//
+// Deprecated: use NewPOAMsgFilterDecorator, which also validates the delegation messages in hybrid mode.
+func NewPOAStakingFilterDecorator(k HybridStakingKeeper) MsgStakingFilterDecorator {
+	return MsgStakingFilterDecorator{
+		hybridKeeper: k,
+	}
+}
+
 // AnteHandle performs an AnteHandler check that returns an error if the tx contains a message that is blocked.
 func (msfd MsgStakingFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	currHeight := ctx.BlockHeight()
@@ -23,7 +42,7 @@ func (msfd MsgStakingFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 		return next(ctx, tx, simulate)
 	}
 
-	err := msfd.hasInvalidStakingMsg(tx.GetMsgs())
+	err := msfd.hasInvalidStakingMsg(ctx, tx.GetMsgs())
 	if err != nil {
 		return ctx, err
 	}
@@ -31,34 +50,25 @@ func (msfd MsgStakingFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 	return next(ctx, tx, simulate)
 }
 
-func (msfd MsgStakingFilterDecorator) hasInvalidStakingMsg(msgs []sdk.Msg) error {
-	for _, msg := range msgs {
-		// authz nested message check (recursive)
-		if execMsg, ok := msg.(*authz.MsgExec); ok {
-			msgs, err := execMsg.GetMessages()
-			if err != nil {
-				return err
-			}
-
-			err = msfd.hasInvalidStakingMsg(msgs)
-			if err != nil {
-				return err
-			}
-		}
-
+func (msfd MsgStakingFilterDecorator) hasInvalidStakingMsg(ctx sdk.Context, msgs []sdk.Msg) error {
+	return WalkMsgs(msgs, func(msg sdk.Msg) error {
 		switch msg.(type) {
 		// POA wrapped messages
-		case *stakingtypes.MsgCreateValidator, *stakingtypes.MsgUpdateParams,
-			// Blocked entirely when POA is enabled
-			*stakingtypes.MsgBeginRedelegate,
+		case *stakingtypes.MsgCreateValidator, *stakingtypes.MsgUpdateParams:
+			return poa.ErrStakingActionNotAllowed
+		// Blocked entirely when POA is enabled, unless allowed by the hybrid mode
+		case *stakingtypes.MsgBeginRedelegate,
 			*stakingtypes.MsgCancelUnbondingDelegation,
 			*stakingtypes.MsgDelegate,
 			*stakingtypes.MsgUndelegate:
-			return poa.ErrStakingActionNotAllowed
+			if msfd.hybridKeeper == nil {
+				return poa.ErrStakingActionNotAllowed
+			}
+
+			return msfd.hybridKeeper.ValidateHybridStakingMsg(ctx, msg)
 		}
 
 		// stakingtypes.MsgEditValidator is the only allowed message. We do not need to check for it.
-	}
-
-	return nil
+		return nil
+	})
 }
@@ -2,7 +2,6 @@ package poaante
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/authz"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/strangelove-ventures/poa"
@@ -11,6 +10,7 @@ import (
 type MsgDisableWithdrawDelegatorRewards struct {
 }
 
+// Deprecated: use NewPOAMsgFilterDecorator, which blocks the message types set in the POA params.
 func NewPOADisableWithdrawDelegatorRewards() MsgDisableWithdrawDelegatorRewards {
 	return MsgDisableWithdrawDelegatorRewards{}
 }
@@ -31,23 +31,11 @@ func (mdwr MsgDisableWithdrawDelegatorRewards) AnteHandle(ctx sdk.Context, tx sd
 }
 
 func (mdwr MsgDisableWithdrawDelegatorRewards) hasWithdrawDelegatorRewardsMsg(msgs []sdk.Msg) error {
-	for _, msg := range msgs {
-		// authz nested message check (recursive)
-		if execMsg, ok := msg.(*authz.MsgExec); ok {
-			msgs, err := execMsg.GetMessages()
-			if err != nil {
-				return err
-			}
-
-			err = mdwr.hasWithdrawDelegatorRewardsMsg(msgs)
-			if err != nil {
-				return err
-			}
-		}
-
+	return WalkMsgs(msgs, func(msg sdk.Msg) error {
 		if _, ok := msg.(*distrtypes.MsgWithdrawDelegatorReward); ok {
 			return poa.ErrWithdrawDelegatorRewardsNotAllowed
 		}
-	}
-	return nil
+
+		return nil
+	})
 }
@@ -0,0 +1,64 @@
+package poaante
+
+import (
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+// icaHostAllowAllMsgs is the interchain accounts host allow_messages entry allowing every message.
+const icaHostAllowAllMsgs = "*"
+
+// ValidateICAHostAllowMessages returns an error if the interchain accounts host `allow_messages` param lets an
+// interchain account execute a message the POA params block. The host executes the messages of a packet without the
+// ante handler, so the POA decorators do not see them. The wildcard and the messages wrapping other messages are
+// rejected as well, and the delegation messages are allowed in hybrid mode, where the POA staking hooks check them.
+func ValidateICAHostAllowMessages(allowMessages []string, params poa.Params) error {
+	for _, typeURL := range allowMessages {
+		switch {
+		case typeURL == icaHostAllowAllMsgs:
+			return errorsmod.Wrap(poa.ErrMsgTypeBlocked, "interchain accounts host can not allow every message")
+		case isWrapperMsgTypeURL(typeURL):
+			return errorsmod.Wrapf(poa.ErrMsgTypeBlocked, "interchain accounts host can not allow %s, its nested messages are not inspected", typeURL)
+		case params.HybridMode && isDelegationMsgTypeURL(typeURL):
+			continue
+		case slices.Contains(params.BlockedMsgTypeUrls, typeURL):
+			return errorsmod.Wrapf(poa.ErrMsgTypeBlocked, "interchain accounts host allows %s", typeURL)
+		}
+	}
+
+	return nil
+}
+
+// isWrapperMsgTypeURL returns true for the messages WalkMsgs looks through.
+func isWrapperMsgTypeURL(typeURL string) bool {
+	switch typeURL {
+	case sdk.MsgTypeURL(&authz.MsgExec{}),
+		sdk.MsgTypeURL(&group.MsgSubmitProposal{}),
+		sdk.MsgTypeURL(&govv1.MsgSubmitProposal{}):
+		return true
+	default:
+		return false
+	}
+}
+
+// isDelegationMsgTypeURL returns true for the x/staking messages token holders may use in hybrid mode.
+func isDelegationMsgTypeURL(typeURL string) bool {
+	switch typeURL {
+	case sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{}):
+		return true
+	default:
+		return false
+	}
+}
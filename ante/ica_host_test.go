@@ -0,0 +1,77 @@
+package poaante
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+func TestValidateICAHostAllowMessages(t *testing.T) {
+	send := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	delegate := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+
+	hybrid := poa.DefaultParams()
+	hybrid.HybridMode = true
+	hybrid.BlockedMsgTypeUrls = poa.HybridBlockedMsgTypeURLs()
+
+	testCases := []struct {
+		name          string
+		allowMessages []string
+		params        poa.Params
+		err           bool
+	}{
+		{
+			name:          "allowed messages",
+			allowMessages: []string{send},
+			params:        poa.DefaultParams(),
+		},
+		{
+			name:          "fail: every message",
+			allowMessages: []string{send, "*"},
+			params:        poa.DefaultParams(),
+			err:           true,
+		},
+		{
+			name:          "fail: blocked message",
+			allowMessages: []string{send, delegate},
+			params:        poa.DefaultParams(),
+			err:           true,
+		},
+		{
+			name:          "fail: message wrapping other messages",
+			allowMessages: []string{sdk.MsgTypeURL(&authz.MsgExec{})},
+			params:        poa.DefaultParams(),
+			err:           true,
+		},
+		{
+			name:          "delegation in hybrid mode",
+			allowMessages: []string{send, delegate},
+			params:        hybrid,
+		},
+		{
+			name:          "fail: create validator in hybrid mode",
+			allowMessages: []string{sdk.MsgTypeURL(&stakingtypes.MsgCreateValidator{})},
+			params:        hybrid,
+			err:           true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateICAHostAllowMessages(tc.allowMessages, tc.params)
+			if tc.err {
+				require.ErrorIs(t, err, poa.ErrMsgTypeBlocked)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package poaante
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaintenanceKeeper validates messages against the POA maintenance mode.
+type MaintenanceKeeper interface {
+	ValidateMaintenanceMsg(ctx context.Context, msg sdk.Msg) error
+}
+
+// MaintenanceDecorator rejects transactions while the chain is in maintenance mode, unless every message, including
+// nested messages (see WalkMsgs), is a POA message sent by the admin or an allowed message type of the maintenance
+// mode. Wrapper messages such as authz MsgExec must be allowed as well. It should be placed before the x/auth
+// DeductFeeDecorator, so that the rejected transactions are not charged any fee.
+type MaintenanceDecorator struct {
+	keeper MaintenanceKeeper
+}
+
+func NewPOAMaintenanceDecorator(k MaintenanceKeeper) MaintenanceDecorator {
+	return MaintenanceDecorator{
+		keeper: k,
+	}
+}
+
+// AnteHandle performs an AnteHandler check that returns an error if the tx is not allowed during maintenance mode.
+func (md MaintenanceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if isGenesisTx(ctx) {
+		// allow GenTx to pass
+		return next(ctx, tx, simulate)
+	}
+
+	if err := WalkMsgs(tx.GetMsgs(), func(msg sdk.Msg) error {
+		return md.keeper.ValidateMaintenanceMsg(ctx, msg)
+	}); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
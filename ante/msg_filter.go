@@ -0,0 +1,50 @@
+package poaante
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgFilterKeeper validates messages against the blocked message types of the POA params.
+type MsgFilterKeeper interface {
+	ValidateMsgAllowed(ctx context.Context, msg sdk.Msg) error
+}
+
+// MsgFilterDecorator rejects transactions with a message type blocked in the POA params, including nested messages (see
+// WalkMsgs). It supersedes the MsgStakingFilterDecorator and MsgDisableWithdrawDelegatorRewards decorators.
+type MsgFilterDecorator struct {
+	keeper MsgFilterKeeper
+}
+
+func NewPOAMsgFilterDecorator(k MsgFilterKeeper) MsgFilterDecorator {
+	return MsgFilterDecorator{
+		keeper: k,
+	}
+}
+
+// AnteHandle performs an AnteHandler check that returns an error if the tx contains a message that is blocked.
+func (mfd MsgFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if isGenesisTx(ctx) {
+		// allow GenTx to pass
+		return next(ctx, tx, simulate)
+	}
+
+	if err := mfd.hasBlockedMsg(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (mfd MsgFilterDecorator) hasBlockedMsg(ctx sdk.Context, msgs []sdk.Msg) error {
+	return WalkMsgs(msgs, func(msg sdk.Msg) error {
+		return mfd.keeper.ValidateMsgAllowed(ctx, msg)
+	})
+}
+
+// isGenesisTx returns true for the gentxs delivered by InitChain. Unlike the transactions of a block, they are not
+// executed in a block with a proposer, whatever the initial height of the chain is.
+func isGenesisTx(ctx sdk.Context) bool {
+	return !ctx.IsCheckTx() && len(ctx.BlockHeader().ProposerAddress) == 0
+}
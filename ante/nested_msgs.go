@@ -0,0 +1,105 @@
+package poaante
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/group"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+// MaxNestedMsgDepth is the maximum number of message wrappers the POA decorators look through.
+const MaxNestedMsgDepth = 5
+
+// WalkMsgs calls fn on every message and on the messages they wrap: authz MsgExec, x/group and x/gov v1 proposals.
+// Nested messages are visited before their wrapper. Messages nested deeper than MaxNestedMsgDepth are rejected.
+// Interchain account host packets are not inspected, see ValidateICAHostAllowMessages.
+func WalkMsgs(msgs []sdk.Msg, fn func(msg sdk.Msg) error) error {
+	return walkMsgs(msgs, 0, fn)
+}
+
+func walkMsgs(msgs []sdk.Msg, depth int, fn func(msg sdk.Msg) error) error {
+	for _, msg := range msgs {
+		nested, err := nestedMsgs(msg)
+		if err != nil {
+			return err
+		}
+
+		if len(nested) > 0 {
+			if depth >= MaxNestedMsgDepth {
+				return errorsmod.Wrapf(poa.ErrNestedMsgDepthExceeded, "maximum depth is %d", MaxNestedMsgDepth)
+			}
+
+			if err := walkMsgs(nested, depth+1, fn); err != nil {
+				return err
+			}
+		}
+
+		if err := fn(msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nestedMsgs returns the messages wrapped by the message, if any.
+func nestedMsgs(msg sdk.Msg) ([]sdk.Msg, error) {
+	switch msg := msg.(type) {
+	case *authz.MsgExec:
+		return unpackMsgs(msg.Msgs)
+	// executed on submission with Exec_EXEC_TRY, or later with the group MsgExec.
+	case *group.MsgSubmitProposal:
+		return unpackMsgs(msg.Messages)
+	case *govv1.MsgSubmitProposal:
+		return unpackMsgs(msg.Messages)
+	default:
+		return nil, nil
+	}
+}
+
+// unpackMsgs returns the messages of the Anys. The cached values are set when the Anys are decoded with the
+// transaction, others are unpacked with the proto registry.
+func unpackMsgs(anys []*codectypes.Any) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, 0, len(anys))
+	for _, a := range anys {
+		if a == nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidType, "nested message is nil")
+		}
+
+		if msg, ok := a.GetCachedValue().(sdk.Msg); ok {
+			msgs = append(msgs, msg)
+
+			continue
+		}
+
+		// same error as the SDK tx.GetMsgs for an Any that is not a known message.
+		msgType := proto.MessageType(strings.TrimPrefix(a.TypeUrl, "/"))
+		if msgType == nil || msgType.Kind() != reflect.Ptr {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %T which is not a sdk.MsgRequest", a)
+		}
+
+		msg, ok := reflect.New(msgType.Elem()).Interface().(sdk.Msg)
+		if !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %T which is not a sdk.MsgRequest", a)
+		}
+
+		if err := proto.Unmarshal(a.Value, msg); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrTxDecode, "nested message %s: %s", a.TypeUrl, err)
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
@@ -0,0 +1,120 @@
+package poaante
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+func newAnys(t *testing.T, msgs ...sdk.Msg) []*types.Any {
+	t.Helper()
+
+	anys := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		a, err := types.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		anys[i] = a
+	}
+
+	return anys
+}
+
+// nestMsgExec wraps the message in depth authz MsgExecs.
+func nestMsgExec(t *testing.T, msg sdk.Msg, depth int) sdk.Msg {
+	t.Helper()
+
+	for i := 0; i < depth; i++ {
+		msg = &authz.MsgExec{Msgs: newAnys(t, msg)}
+	}
+
+	return msg
+}
+
+func TestWalkMsgs(t *testing.T) {
+	delegate := &stakingtypes.MsgDelegate{DelegatorAddress: "delegator"}
+	delegateURL := sdk.MsgTypeURL(delegate)
+
+	testCases := []struct {
+		name    string
+		msg     sdk.Msg
+		visited []string
+		err     error
+	}{
+		{
+			name:    "message without nested messages",
+			msg:     delegate,
+			visited: []string{delegateURL},
+		},
+		{
+			name:    "authz exec",
+			msg:     &authz.MsgExec{Msgs: newAnys(t, delegate)},
+			visited: []string{delegateURL, sdk.MsgTypeURL(&authz.MsgExec{})},
+		},
+		{
+			name: "group proposal executed on submission",
+			msg: &group.MsgSubmitProposal{
+				Messages: newAnys(t, delegate),
+				Exec:     group.Exec_EXEC_TRY,
+			},
+			visited: []string{delegateURL, sdk.MsgTypeURL(&group.MsgSubmitProposal{})},
+		},
+		{
+			name:    "gov v1 proposal",
+			msg:     &govv1.MsgSubmitProposal{Messages: newAnys(t, delegate)},
+			visited: []string{delegateURL, sdk.MsgTypeURL(&govv1.MsgSubmitProposal{})},
+		},
+		{
+			name: "fail: unknown nested message",
+			msg:  &authz.MsgExec{Msgs: []*types.Any{{TypeUrl: "/unknown.MsgUnknown"}}},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:    "nested at the maximum depth",
+			msg:     nestMsgExec(t, delegate, MaxNestedMsgDepth),
+			visited: append([]string{delegateURL}, repeat(sdk.MsgTypeURL(&authz.MsgExec{}), MaxNestedMsgDepth)...),
+		},
+		{
+			name: "fail: nested deeper than the maximum depth",
+			msg:  nestMsgExec(t, delegate, MaxNestedMsgDepth+1),
+			err:  poa.ErrNestedMsgDepthExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var visited []string
+			err := WalkMsgs([]sdk.Msg{tc.msg}, func(msg sdk.Msg) error {
+				visited = append(visited, sdk.MsgTypeURL(msg))
+				return nil
+			})
+
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.visited, visited)
+		})
+	}
+}
+
+func repeat(s string, n int) []string {
+	out := make([]string, n)
+	for i := range out {
+		out[i] = s
+	}
+
+	return out
+}
@@ -22,6 +22,15 @@ const (
 	Query_PendingValidators_FullMethodName = "/strangelove_ventures.poa.v1.Query/PendingValidators"
 	Query_ConsensusPower_FullMethodName    = "/strangelove_ventures.poa.v1.Query/ConsensusPower"
 	Query_PoaAuthority_FullMethodName      = "/strangelove_ventures.poa.v1.Query/PoaAuthority"
+	Query_Organizations_FullMethodName     = "/strangelove_ventures.poa.v1.Query/Organizations"
+	Query_OrganizationPower_FullMethodName = "/strangelove_ventures.poa.v1.Query/OrganizationPower"
+	Query_AuditLog_FullMethodName          = "/strangelove_ventures.poa.v1.Query/AuditLog"
+	Query_Params_FullMethodName            = "/strangelove_ventures.poa.v1.Query/Params"
+	Query_AccountAllowlist_FullMethodName  = "/strangelove_ventures.poa.v1.Query/AccountAllowlist"
+	Query_MaintenanceMode_FullMethodName   = "/strangelove_ventures.poa.v1.Query/MaintenanceMode"
+	Query_Status_FullMethodName            = "/strangelove_ventures.poa.v1.Query/Status"
+	Query_FeeRecipient_FullMethodName      = "/strangelove_ventures.poa.v1.Query/FeeRecipient"
+	Query_FeeRecipients_FullMethodName     = "/strangelove_ventures.poa.v1.Query/FeeRecipients"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +43,25 @@ type QueryClient interface {
 	ConsensusPower(ctx context.Context, in *QueryConsensusPowerRequest, opts ...grpc.CallOption) (*QueryConsensusPowerResponse, error)
 	// POA Authority
 	PoaAuthority(ctx context.Context, in *QueryPoaAuthorityRequest, opts ...grpc.CallOption) (*QueryPoaAuthorityResponse, error)
+	// Organizations returns all registered organizations.
+	Organizations(ctx context.Context, in *QueryOrganizationsRequest, opts ...grpc.CallOption) (*QueryOrganizationsResponse, error)
+	// OrganizationPower returns the validators and combined power of an organization.
+	OrganizationPower(ctx context.Context, in *QueryOrganizationPowerRequest, opts ...grpc.CallOption) (*QueryOrganizationPowerResponse, error)
+	// AuditLog returns the recorded administrative actions.
+	AuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
+	// Params returns the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// AccountAllowlist returns the accounts and address prefixes of the account
+	// allowlist.
+	AccountAllowlist(ctx context.Context, in *QueryAccountAllowlistRequest, opts ...grpc.CallOption) (*QueryAccountAllowlistResponse, error)
+	// MaintenanceMode returns the maintenance mode and whether it is active.
+	MaintenanceMode(ctx context.Context, in *QueryMaintenanceModeRequest, opts ...grpc.CallOption) (*QueryMaintenanceModeResponse, error)
+	// Status returns whether the validator set operations are paused.
+	Status(ctx context.Context, in *QueryStatusRequest, opts ...grpc.CallOption) (*QueryStatusResponse, error)
+	// FeeRecipient returns the accrued and paid fees of an account.
+	FeeRecipient(ctx context.Context, in *QueryFeeRecipientRequest, opts ...grpc.CallOption) (*QueryFeeRecipientResponse, error)
+	// FeeRecipients returns the accrued and paid fees of all the accounts.
+	FeeRecipients(ctx context.Context, in *QueryFeeRecipientsRequest, opts ...grpc.CallOption) (*QueryFeeRecipientsResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +99,87 @@ func (c *queryClient) PoaAuthority(ctx context.Context, in *QueryPoaAuthorityReq
 	return out, nil
 }
 
+func (c *queryClient) Organizations(ctx context.Context, in *QueryOrganizationsRequest, opts ...grpc.CallOption) (*QueryOrganizationsResponse, error) {
+	out := new(QueryOrganizationsResponse)
+	err := c.cc.Invoke(ctx, Query_Organizations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OrganizationPower(ctx context.Context, in *QueryOrganizationPowerRequest, opts ...grpc.CallOption) (*QueryOrganizationPowerResponse, error) {
+	out := new(QueryOrganizationPowerResponse)
+	err := c.cc.Invoke(ctx, Query_OrganizationPower_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, Query_AuditLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountAllowlist(ctx context.Context, in *QueryAccountAllowlistRequest, opts ...grpc.CallOption) (*QueryAccountAllowlistResponse, error) {
+	out := new(QueryAccountAllowlistResponse)
+	err := c.cc.Invoke(ctx, Query_AccountAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MaintenanceMode(ctx context.Context, in *QueryMaintenanceModeRequest, opts ...grpc.CallOption) (*QueryMaintenanceModeResponse, error) {
+	out := new(QueryMaintenanceModeResponse)
+	err := c.cc.Invoke(ctx, Query_MaintenanceMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Status(ctx context.Context, in *QueryStatusRequest, opts ...grpc.CallOption) (*QueryStatusResponse, error) {
+	out := new(QueryStatusResponse)
+	err := c.cc.Invoke(ctx, Query_Status_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeRecipient(ctx context.Context, in *QueryFeeRecipientRequest, opts ...grpc.CallOption) (*QueryFeeRecipientResponse, error) {
+	out := new(QueryFeeRecipientResponse)
+	err := c.cc.Invoke(ctx, Query_FeeRecipient_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeRecipients(ctx context.Context, in *QueryFeeRecipientsRequest, opts ...grpc.CallOption) (*QueryFeeRecipientsResponse, error) {
+	out := new(QueryFeeRecipientsResponse)
+	err := c.cc.Invoke(ctx, Query_FeeRecipients_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +190,25 @@ type QueryServer interface {
 	ConsensusPower(context.Context, *QueryConsensusPowerRequest) (*QueryConsensusPowerResponse, error)
 	// POA Authority
 	PoaAuthority(context.Context, *QueryPoaAuthorityRequest) (*QueryPoaAuthorityResponse, error)
+	// Organizations returns all registered organizations.
+	Organizations(context.Context, *QueryOrganizationsRequest) (*QueryOrganizationsResponse, error)
+	// OrganizationPower returns the validators and combined power of an organization.
+	OrganizationPower(context.Context, *QueryOrganizationPowerRequest) (*QueryOrganizationPowerResponse, error)
+	// AuditLog returns the recorded administrative actions.
+	AuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
+	// Params returns the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// AccountAllowlist returns the accounts and address prefixes of the account
+	// allowlist.
+	AccountAllowlist(context.Context, *QueryAccountAllowlistRequest) (*QueryAccountAllowlistResponse, error)
+	// MaintenanceMode returns the maintenance mode and whether it is active.
+	MaintenanceMode(context.Context, *QueryMaintenanceModeRequest) (*QueryMaintenanceModeResponse, error)
+	// Status returns whether the validator set operations are paused.
+	Status(context.Context, *QueryStatusRequest) (*QueryStatusResponse, error)
+	// FeeRecipient returns the accrued and paid fees of an account.
+	FeeRecipient(context.Context, *QueryFeeRecipientRequest) (*QueryFeeRecipientResponse, error)
+	// FeeRecipients returns the accrued and paid fees of all the accounts.
+	FeeRecipients(context.Context, *QueryFeeRecipientsRequest) (*QueryFeeRecipientsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +225,33 @@ func (UnimplementedQueryServer) ConsensusPower(context.Context, *QueryConsensusP
 func (UnimplementedQueryServer) PoaAuthority(context.Context, *QueryPoaAuthorityRequest) (*QueryPoaAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoaAuthority not implemented")
 }
+func (UnimplementedQueryServer) Organizations(context.Context, *QueryOrganizationsRequest) (*QueryOrganizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Organizations not implemented")
+}
+func (UnimplementedQueryServer) OrganizationPower(context.Context, *QueryOrganizationPowerRequest) (*QueryOrganizationPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrganizationPower not implemented")
+}
+func (UnimplementedQueryServer) AuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditLog not implemented")
+}
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) AccountAllowlist(context.Context, *QueryAccountAllowlistRequest) (*QueryAccountAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountAllowlist not implemented")
+}
+func (UnimplementedQueryServer) MaintenanceMode(context.Context, *QueryMaintenanceModeRequest) (*QueryMaintenanceModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaintenanceMode not implemented")
+}
+func (UnimplementedQueryServer) Status(context.Context, *QueryStatusRequest) (*QueryStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (UnimplementedQueryServer) FeeRecipient(context.Context, *QueryFeeRecipientRequest) (*QueryFeeRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeRecipient not implemented")
+}
+func (UnimplementedQueryServer) FeeRecipients(context.Context, *QueryFeeRecipientsRequest) (*QueryFeeRecipientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeRecipients not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +319,168 @@ func _Query_PoaAuthority_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Organizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrganizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Organizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Organizations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Organizations(ctx, req.(*QueryOrganizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OrganizationPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrganizationPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrganizationPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OrganizationPower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrganizationPower(ctx, req.(*QueryOrganizationPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountAllowlist(ctx, req.(*QueryAccountAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaintenanceModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaintenanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MaintenanceMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaintenanceMode(ctx, req.(*QueryMaintenanceModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Status_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Status(ctx, req.(*QueryStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeRecipient(ctx, req.(*QueryFeeRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeRecipients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeRecipientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeRecipients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeRecipients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeRecipients(ctx, req.(*QueryFeeRecipientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +500,42 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PoaAuthority",
 			Handler:    _Query_PoaAuthority_Handler,
 		},
+		{
+			MethodName: "Organizations",
+			Handler:    _Query_Organizations_Handler,
+		},
+		{
+			MethodName: "OrganizationPower",
+			Handler:    _Query_OrganizationPower_Handler,
+		},
+		{
+			MethodName: "AuditLog",
+			Handler:    _Query_AuditLog_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AccountAllowlist",
+			Handler:    _Query_AccountAllowlist_Handler,
+		},
+		{
+			MethodName: "MaintenanceMode",
+			Handler:    _Query_MaintenanceMode_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _Query_Status_Handler,
+		},
+		{
+			MethodName: "FeeRecipient",
+			Handler:    _Query_FeeRecipient_Handler,
+		},
+		{
+			MethodName: "FeeRecipients",
+			Handler:    _Query_FeeRecipients_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "strangelove_ventures/poa/v1/query.proto",
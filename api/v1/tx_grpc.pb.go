@@ -19,11 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_CreateValidator_FullMethodName     = "/strangelove_ventures.poa.v1.Msg/CreateValidator"
-	Msg_SetPower_FullMethodName            = "/strangelove_ventures.poa.v1.Msg/SetPower"
-	Msg_RemoveValidator_FullMethodName     = "/strangelove_ventures.poa.v1.Msg/RemoveValidator"
-	Msg_RemovePending_FullMethodName       = "/strangelove_ventures.poa.v1.Msg/RemovePending"
-	Msg_UpdateStakingParams_FullMethodName = "/strangelove_ventures.poa.v1.Msg/UpdateStakingParams"
+	Msg_CreateValidator_FullMethodName          = "/strangelove_ventures.poa.v1.Msg/CreateValidator"
+	Msg_SetPower_FullMethodName                 = "/strangelove_ventures.poa.v1.Msg/SetPower"
+	Msg_RemoveValidator_FullMethodName          = "/strangelove_ventures.poa.v1.Msg/RemoveValidator"
+	Msg_RemovePending_FullMethodName            = "/strangelove_ventures.poa.v1.Msg/RemovePending"
+	Msg_UpdateStakingParams_FullMethodName      = "/strangelove_ventures.poa.v1.Msg/UpdateStakingParams"
+	Msg_UpdateSlashingParams_FullMethodName     = "/strangelove_ventures.poa.v1.Msg/UpdateSlashingParams"
+	Msg_UpdateParams_FullMethodName             = "/strangelove_ventures.poa.v1.Msg/UpdateParams"
+	Msg_RegisterOrganization_FullMethodName     = "/strangelove_ventures.poa.v1.Msg/RegisterOrganization"
+	Msg_SetValidatorOrganization_FullMethodName = "/strangelove_ventures.poa.v1.Msg/SetValidatorOrganization"
+	Msg_UpdateAccountAllowlist_FullMethodName   = "/strangelove_ventures.poa.v1.Msg/UpdateAccountAllowlist"
+	Msg_SetMaintenanceMode_FullMethodName       = "/strangelove_ventures.poa.v1.Msg/SetMaintenanceMode"
+	Msg_Pause_FullMethodName                    = "/strangelove_ventures.poa.v1.Msg/Pause"
+	Msg_Unpause_FullMethodName                  = "/strangelove_ventures.poa.v1.Msg/Unpause"
 )
 
 // MsgClient is the client API for Msg service.
@@ -38,8 +46,24 @@ type MsgClient interface {
 	RemoveValidator(ctx context.Context, in *MsgRemoveValidator, opts ...grpc.CallOption) (*MsgRemoveValidatorResponse, error)
 	// RemovePending removes a pending validator from the queue.
 	RemovePending(ctx context.Context, in *MsgRemovePending, opts ...grpc.CallOption) (*MsgRemovePendingResponse, error)
-	// UpdateStakingParams updates the module parameters.
+	// UpdateStakingParams updates the x/staking module parameters.
 	UpdateStakingParams(ctx context.Context, in *MsgUpdateStakingParams, opts ...grpc.CallOption) (*MsgUpdateStakingParamsResponse, error)
+	// UpdateSlashingParams updates the x/slashing module parameters.
+	UpdateSlashingParams(ctx context.Context, in *MsgUpdateSlashingParams, opts ...grpc.CallOption) (*MsgUpdateSlashingParamsResponse, error)
+	// UpdateParams updates the module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterOrganization registers or updates an organization of validators.
+	RegisterOrganization(ctx context.Context, in *MsgRegisterOrganization, opts ...grpc.CallOption) (*MsgRegisterOrganizationResponse, error)
+	// SetValidatorOrganization assigns a validator to an organization.
+	SetValidatorOrganization(ctx context.Context, in *MsgSetValidatorOrganization, opts ...grpc.CallOption) (*MsgSetValidatorOrganizationResponse, error)
+	// UpdateAccountAllowlist adds and removes accounts and address prefixes of the account allowlist.
+	UpdateAccountAllowlist(ctx context.Context, in *MsgUpdateAccountAllowlist, opts ...grpc.CallOption) (*MsgUpdateAccountAllowlistResponse, error)
+	// SetMaintenanceMode enables or disables maintenance mode.
+	SetMaintenanceMode(ctx context.Context, in *MsgSetMaintenanceMode, opts ...grpc.CallOption) (*MsgSetMaintenanceModeResponse, error)
+	// Pause stops the validator set operations of the module.
+	Pause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error)
+	// Unpause resumes the validator set operations of the module.
+	Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error)
 }
 
 type msgClient struct {
@@ -95,6 +119,78 @@ func (c *msgClient) UpdateStakingParams(ctx context.Context, in *MsgUpdateStakin
 	return out, nil
 }
 
+func (c *msgClient) UpdateSlashingParams(ctx context.Context, in *MsgUpdateSlashingParams, opts ...grpc.CallOption) (*MsgUpdateSlashingParamsResponse, error) {
+	out := new(MsgUpdateSlashingParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateSlashingParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterOrganization(ctx context.Context, in *MsgRegisterOrganization, opts ...grpc.CallOption) (*MsgRegisterOrganizationResponse, error) {
+	out := new(MsgRegisterOrganizationResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterOrganization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetValidatorOrganization(ctx context.Context, in *MsgSetValidatorOrganization, opts ...grpc.CallOption) (*MsgSetValidatorOrganizationResponse, error) {
+	out := new(MsgSetValidatorOrganizationResponse)
+	err := c.cc.Invoke(ctx, Msg_SetValidatorOrganization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateAccountAllowlist(ctx context.Context, in *MsgUpdateAccountAllowlist, opts ...grpc.CallOption) (*MsgUpdateAccountAllowlistResponse, error) {
+	out := new(MsgUpdateAccountAllowlistResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateAccountAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMaintenanceMode(ctx context.Context, in *MsgSetMaintenanceMode, opts ...grpc.CallOption) (*MsgSetMaintenanceModeResponse, error) {
+	out := new(MsgSetMaintenanceModeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetMaintenanceMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Pause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error) {
+	out := new(MsgPauseResponse)
+	err := c.cc.Invoke(ctx, Msg_Pause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error) {
+	out := new(MsgUnpauseResponse)
+	err := c.cc.Invoke(ctx, Msg_Unpause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -107,8 +203,24 @@ type MsgServer interface {
 	RemoveValidator(context.Context, *MsgRemoveValidator) (*MsgRemoveValidatorResponse, error)
 	// RemovePending removes a pending validator from the queue.
 	RemovePending(context.Context, *MsgRemovePending) (*MsgRemovePendingResponse, error)
-	// UpdateStakingParams updates the module parameters.
+	// UpdateStakingParams updates the x/staking module parameters.
 	UpdateStakingParams(context.Context, *MsgUpdateStakingParams) (*MsgUpdateStakingParamsResponse, error)
+	// UpdateSlashingParams updates the x/slashing module parameters.
+	UpdateSlashingParams(context.Context, *MsgUpdateSlashingParams) (*MsgUpdateSlashingParamsResponse, error)
+	// UpdateParams updates the module parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterOrganization registers or updates an organization of validators.
+	RegisterOrganization(context.Context, *MsgRegisterOrganization) (*MsgRegisterOrganizationResponse, error)
+	// SetValidatorOrganization assigns a validator to an organization.
+	SetValidatorOrganization(context.Context, *MsgSetValidatorOrganization) (*MsgSetValidatorOrganizationResponse, error)
+	// UpdateAccountAllowlist adds and removes accounts and address prefixes of the account allowlist.
+	UpdateAccountAllowlist(context.Context, *MsgUpdateAccountAllowlist) (*MsgUpdateAccountAllowlistResponse, error)
+	// SetMaintenanceMode enables or disables maintenance mode.
+	SetMaintenanceMode(context.Context, *MsgSetMaintenanceMode) (*MsgSetMaintenanceModeResponse, error)
+	// Pause stops the validator set operations of the module.
+	Pause(context.Context, *MsgPause) (*MsgPauseResponse, error)
+	// Unpause resumes the validator set operations of the module.
+	Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -131,6 +243,30 @@ func (UnimplementedMsgServer) RemovePending(context.Context, *MsgRemovePending)
 func (UnimplementedMsgServer) UpdateStakingParams(context.Context, *MsgUpdateStakingParams) (*MsgUpdateStakingParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateStakingParams not implemented")
 }
+func (UnimplementedMsgServer) UpdateSlashingParams(context.Context, *MsgUpdateSlashingParams) (*MsgUpdateSlashingParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSlashingParams not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) RegisterOrganization(context.Context, *MsgRegisterOrganization) (*MsgRegisterOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterOrganization not implemented")
+}
+func (UnimplementedMsgServer) SetValidatorOrganization(context.Context, *MsgSetValidatorOrganization) (*MsgSetValidatorOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorOrganization not implemented")
+}
+func (UnimplementedMsgServer) UpdateAccountAllowlist(context.Context, *MsgUpdateAccountAllowlist) (*MsgUpdateAccountAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountAllowlist not implemented")
+}
+func (UnimplementedMsgServer) SetMaintenanceMode(context.Context, *MsgSetMaintenanceMode) (*MsgSetMaintenanceModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaintenanceMode not implemented")
+}
+func (UnimplementedMsgServer) Pause(context.Context, *MsgPause) (*MsgPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (UnimplementedMsgServer) Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unpause not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -234,6 +370,150 @@ func _Msg_UpdateStakingParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateSlashingParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSlashingParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSlashingParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateSlashingParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSlashingParams(ctx, req.(*MsgUpdateSlashingParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterOrganization)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterOrganization(ctx, req.(*MsgRegisterOrganization))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetValidatorOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorOrganization)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetValidatorOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorOrganization(ctx, req.(*MsgSetValidatorOrganization))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAccountAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAccountAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAccountAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateAccountAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAccountAllowlist(ctx, req.(*MsgUpdateAccountAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMaintenanceMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMaintenanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMaintenanceMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMaintenanceMode(ctx, req.(*MsgSetMaintenanceMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Pause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Pause(ctx, req.(*MsgPause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unpause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unpause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Unpause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unpause(ctx, req.(*MsgUnpause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -261,6 +541,38 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateStakingParams",
 			Handler:    _Msg_UpdateStakingParams_Handler,
 		},
+		{
+			MethodName: "UpdateSlashingParams",
+			Handler:    _Msg_UpdateSlashingParams_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RegisterOrganization",
+			Handler:    _Msg_RegisterOrganization_Handler,
+		},
+		{
+			MethodName: "SetValidatorOrganization",
+			Handler:    _Msg_SetValidatorOrganization_Handler,
+		},
+		{
+			MethodName: "UpdateAccountAllowlist",
+			Handler:    _Msg_UpdateAccountAllowlist_Handler,
+		},
+		{
+			MethodName: "SetMaintenanceMode",
+			Handler:    _Msg_SetMaintenanceMode_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _Msg_Pause_Handler,
+		},
+		{
+			MethodName: "Unpause",
+			Handler:    _Msg_Unpause_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "strangelove_ventures/poa/v1/tx.proto",
@@ -0,0 +1,54 @@
+package poa
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Audit log actions recorded for administrative messages.
+const (
+	AuditActionSetPower                 = "set_power"
+	AuditActionRemoveValidator          = "remove_validator"
+	AuditActionRemovePending            = "remove_pending"
+	AuditActionUpdateStakingParams      = "update_staking_params"
+	AuditActionUpdateSlashingParams     = "update_slashing_params"
+	AuditActionUpdateParams             = "update_params"
+	AuditActionRegisterOrganization     = "register_organization"
+	AuditActionSetValidatorOrganization = "set_validator_organization"
+	AuditActionUpdateAccountAllowlist   = "update_account_allowlist"
+	AuditActionSetMaintenanceMode       = "set_maintenance_mode"
+	AuditActionPause                    = "pause"
+	AuditActionUnpause                  = "unpause"
+	AuditActionUpdateAuthority          = "update_authority"
+)
+
+var _ codectypes.UnpackInterfacesMessage = AuditLogEntry{}
+
+// Validate checks the recorded admin and guardian addresses, the guardian may be unset.
+func (a Authorities) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Authority); err != nil {
+		return fmt.Errorf("invalid recorded authority %s: %w", a.Authority, err)
+	}
+
+	if a.Guardian == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(a.Guardian); err != nil {
+		return fmt.Errorf("invalid recorded guardian %s: %w", a.Guardian, err)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (e AuditLogEntry) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if e.Msg == nil {
+		return nil
+	}
+
+	var msg sdk.Msg
+	return unpacker.UnpackAny(e.Msg, &msg)
+}
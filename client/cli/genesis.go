@@ -0,0 +1,194 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+const (
+	FlagPubKey                  = "pubkey"
+	FlagMoniker                 = "moniker"
+	FlagIdentity                = "identity"
+	FlagWebsite                 = "website"
+	FlagSecurityContact         = "security-contact"
+	FlagDetails                 = "details"
+	FlagCommissionRate          = "commission-rate"
+	FlagCommissionMaxRate       = "commission-max-rate"
+	FlagCommissionMaxChangeRate = "commission-max-change-rate"
+)
+
+// AddGenesisValidatorCmd returns the add-poa-validator cobra Command. It adds an active validator with an explicit
+// power to the POA genesis state, in place of a staking gentx.
+func AddGenesisValidatorCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-poa-validator [validator_address_or_key_name] [power]",
+		Short: "Add an active POA validator with a set power to genesis.json",
+		Long: `Add an active POA validator to genesis.json, installed into the validator set at genesis without a gentx.
+The validator operator is given as a validator address or a key name from the local keyring. The power uses 10^6
+precision (1,000,000 = 1 power). The consensus public key defaults to this node's validator key.`,
+		Example: fmt.Sprintf(`$ %s genesis add-poa-validator mykey 1000000 --moniker "My Validator"
+$ %s genesis add-poa-validator cosmosvaloper1... 1000000 --pubkey '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"..."}'`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				inBuf := bufio.NewReader(cmd.InOrStdin())
+				keyringBackend, _ := cmd.Flags().GetString(flags.FlagKeyringBackend)
+
+				kr := clientCtx.Keyring
+				if keyringBackend != "" && kr == nil {
+					kr, err = keyring.New(sdk.KeyringServiceName(), keyringBackend, clientCtx.HomeDir, inBuf, clientCtx.Codec)
+					if err != nil {
+						return err
+					}
+				}
+
+				k, err := kr.Key(args[0])
+				if err != nil {
+					return fmt.Errorf("failed to get address from Keyring: %w", err)
+				}
+
+				addr, err := k.GetAddress()
+				if err != nil {
+					return err
+				}
+				valAddr = sdk.ValAddress(addr)
+			}
+
+			power, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("strconv.ParseUint failed: %w", err)
+			}
+
+			var pk cryptotypes.PubKey
+			if pkStr, _ := cmd.Flags().GetString(FlagPubKey); pkStr != "" {
+				if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(pkStr), &pk); err != nil {
+					return fmt.Errorf("failed to parse pubkey: %w", err)
+				}
+			} else {
+				_, pk, err = genutil.InitializeNodeValidatorFiles(config)
+				if err != nil {
+					return fmt.Errorf("failed to load the node validator key: %w", err)
+				}
+			}
+
+			pkAny, err := codectypes.NewAnyWithValue(pk)
+			if err != nil {
+				return err
+			}
+
+			moniker, _ := cmd.Flags().GetString(FlagMoniker)
+			if moniker == "" {
+				moniker = config.Moniker
+			}
+			identity, _ := cmd.Flags().GetString(FlagIdentity)
+			website, _ := cmd.Flags().GetString(FlagWebsite)
+			securityContact, _ := cmd.Flags().GetString(FlagSecurityContact)
+			details, _ := cmd.Flags().GetString(FlagDetails)
+
+			commission, err := parseCommissionRateFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			appState, appGenesis, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			poaGenState := poa.NewGenesisState()
+			if bz, ok := appState[poa.ModuleName]; ok {
+				if err := clientCtx.Codec.UnmarshalJSON(bz, poaGenState); err != nil {
+					return fmt.Errorf("failed to unmarshal %s genesis state: %w", poa.ModuleName, err)
+				}
+			}
+
+			poaGenState.Validators = append(poaGenState.Validators, poa.GenesisValidator{
+				OperatorAddress: valAddr.String(),
+				ConsensusPubkey: pkAny,
+				Description:     poa.NewDescription(moniker, identity, website, securityContact, details),
+				Commission:      commission,
+				Power:           power,
+			})
+
+			if err := poaGenState.Validate(); err != nil {
+				return err
+			}
+
+			appState[poa.ModuleName], err = clientCtx.Codec.MarshalJSON(poaGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal %s genesis state: %w", poa.ModuleName, err)
+			}
+
+			appGenesis.AppState, err = json.MarshalIndent(appState, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			return genutil.ExportGenesisFile(appGenesis, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(FlagPubKey, "", "The validator's consensus public key as JSON (defaults to this node's validator key)")
+	cmd.Flags().String(FlagMoniker, "", "The validator's name (defaults to this node's moniker)")
+	cmd.Flags().String(FlagIdentity, "", "The optional identity signature (ex. UPort or Keybase)")
+	cmd.Flags().String(FlagWebsite, "", "The validator's (optional) website")
+	cmd.Flags().String(FlagSecurityContact, "", "The validator's (optional) security contact email")
+	cmd.Flags().String(FlagDetails, "", "The validator's (optional) details")
+	cmd.Flags().String(FlagCommissionRate, "0.1", "The initial commission rate percentage")
+	cmd.Flags().String(FlagCommissionMaxRate, "0.2", "The maximum commission rate percentage")
+	cmd.Flags().String(FlagCommissionMaxChangeRate, "0.01", "The maximum commission change rate percentage (per day)")
+
+	return cmd
+}
+
+func parseCommissionRateFlags(cmd *cobra.Command) (poa.CommissionRates, error) {
+	rateStr, _ := cmd.Flags().GetString(FlagCommissionRate)
+	maxRateStr, _ := cmd.Flags().GetString(FlagCommissionMaxRate)
+	maxChangeRateStr, _ := cmd.Flags().GetString(FlagCommissionMaxChangeRate)
+
+	rate, err := math.LegacyNewDecFromStr(rateStr)
+	if err != nil {
+		return poa.CommissionRates{}, fmt.Errorf("invalid commission rate: %w", err)
+	}
+
+	maxRate, err := math.LegacyNewDecFromStr(maxRateStr)
+	if err != nil {
+		return poa.CommissionRates{}, fmt.Errorf("invalid commission max rate: %w", err)
+	}
+
+	maxChangeRate, err := math.LegacyNewDecFromStr(maxChangeRateStr)
+	if err != nil {
+		return poa.CommissionRates{}, fmt.Errorf("invalid commission max change rate: %w", err)
+	}
+
+	return poa.NewCommissionRates(rate, maxRate, maxChangeRate), nil
+}
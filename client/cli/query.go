@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+const (
+	FlagAction    = "action"
+	FlagValidator = "validator"
+
+	// OutputFormatCSV exports query results as comma separated values.
+	OutputFormatCSV = "csv"
+)
+
+// NewQueryCmd returns a root CLI command handler for the x/POA query commands not generated by autocli.
+func NewQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        poa.ModuleName,
+		Short:                      poa.ModuleName + " query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		NewAuditLogCmd(),
+	)
+
+	return queryCmd
+}
+
+func NewAuditLogCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit-log",
+		Short: "query the audit log of administrative actions",
+		Example: fmt.Sprintf(`$ %s q poa audit-log --action set_power --validator cosmosvaloper1...
+$ %s q poa audit-log --output csv > audit.csv`, version.AppName, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			action, err := cmd.Flags().GetString(FlagAction)
+			if err != nil {
+				return err
+			}
+
+			validator, err := cmd.Flags().GetString(FlagValidator)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := poa.NewQueryClient(clientCtx).AuditLog(cmd.Context(), &poa.QueryAuditLogRequest{
+				Action:           action,
+				ValidatorAddress: validator,
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.OutputFormat != OutputFormatCSV {
+				return clientCtx.PrintProto(res)
+			}
+
+			return writeAuditLogCSV(clientCtx, res.Entries)
+		},
+	}
+
+	cmd.Flags().String(FlagAction, "", "filter by action (e.g. set_power, remove_validator, remove_pending, update_staking_params)")
+	cmd.Flags().String(FlagValidator, "", "filter by validator operator address")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "audit-log")
+	cmd.Flags().Lookup(flags.FlagOutput).Usage = "Output format (text|json|csv)"
+
+	return cmd
+}
+
+// writeAuditLogCSV writes the audit log entries with a header row to the client output.
+func writeAuditLogCSV(clientCtx client.Context, entries []poa.AuditLogEntry) error {
+	out := clientCtx.Output
+	if out == nil {
+		out = os.Stdout
+	}
+
+	w := csv.NewWriter(out)
+
+	if err := w.Write([]string{"id", "action", "signer", "validator_address", "height", "time", "tx_hash", "msg"}); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		var msg string
+		if entry.Msg != nil {
+			bz, err := clientCtx.Codec.MarshalJSON(entry.Msg)
+			if err != nil {
+				return err
+			}
+			msg = string(bz)
+		}
+
+		if err := w.Write([]string{
+			strconv.FormatUint(entry.Id, 10),
+			entry.Action,
+			entry.Signer,
+			entry.ValidatorAddress,
+			strconv.FormatInt(entry.Height, 10),
+			entry.Time.UTC().Format(time.RFC3339),
+			entry.TxHash,
+			msg,
+		}); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+
+	return w.Error()
+}
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+func TestWriteAuditLogCSV(t *testing.T) {
+	require := require.New(t)
+
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	poa.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	msg, err := codectypes.NewAnyWithValue(&poa.MsgSetPower{
+		Sender:           "cosmos1sender",
+		ValidatorAddress: "cosmosvaloper1val",
+		Power:            2_000_000,
+	})
+	require.NoError(err)
+
+	blockTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600))
+	entries := []poa.AuditLogEntry{
+		{
+			Id:               1,
+			Action:           "set_power",
+			Signer:           "cosmos1sender",
+			ValidatorAddress: "cosmosvaloper1val",
+			Height:           10,
+			Time:             blockTime,
+			TxHash:           "ABCD",
+			Msg:              msg,
+		},
+		{
+			// the values are written as stored, any comma, quote or newline is escaped.
+			Id:     2,
+			Action: "remove_pending",
+			Signer: "cosmos1a,b",
+			Height: 11,
+			Time:   blockTime,
+			TxHash: "say \"hi\"\nbye",
+		},
+	}
+
+	var out bytes.Buffer
+	clientCtx := client.Context{}.WithCodec(encCfg.Codec).WithOutput(&out)
+	require.NoError(writeAuditLogCSV(clientCtx, entries))
+
+	msgJSON, err := encCfg.Codec.MarshalJSON(msg)
+	require.NoError(err)
+
+	require.Equal(
+		"id,action,signer,validator_address,height,time,tx_hash,msg\n"+
+			"1,set_power,cosmos1sender,cosmosvaloper1val,10,2024-01-02T02:04:05Z,ABCD,\""+string(bytes.ReplaceAll(msgJSON, []byte(`"`), []byte(`""`)))+"\"\n"+
+			"2,remove_pending,\"cosmos1a,b\",,11,2024-01-02T02:04:05Z,\"say \"\"hi\"\"\nbye\",\n",
+		out.String(),
+	)
+
+	// the output parses back into the same values.
+	records, err := csv.NewReader(&out).ReadAll()
+	require.NoError(err)
+	require.Len(records, 3)
+	require.Equal(string(msgJSON), records[1][7])
+	require.Equal([]string{"2", "remove_pending", "cosmos1a,b", "", "11", "2024-01-02T02:04:05Z", "say \"hi\"\nbye", ""}, records[2])
+}
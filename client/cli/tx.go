@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -25,6 +26,14 @@ const (
 	FlagNodeID  = "node-id"
 	FlagIP      = "ip"
 	FlagP2PPort = "p2p-port"
+
+	FlagAddAccounts    = "add-accounts"
+	FlagRemoveAccounts = "remove-accounts"
+	FlagAddPrefixes    = "add-prefixes"
+	FlagRemovePrefixes = "remove-prefixes"
+
+	FlagExpiryHeight       = "expiry-height"
+	FlagAllowedMsgTypeURLs = "allowed-msg-type-urls"
 )
 
 // NewTxCmd returns a root CLI command handler for all x/POA transaction commands.
@@ -43,6 +52,14 @@ func NewTxCmd(ac address.Codec) *cobra.Command {
 		NewRemovePendingCmd(),
 		NewRemoveValidatorCmd(),
 		NewUpdateStakingParamsCmd(),
+		NewUpdateSlashingParamsCmd(),
+		NewUpdateParamsCmd(),
+		NewRegisterOrganizationCmd(),
+		NewSetValidatorOrganizationCmd(),
+		NewUpdateAccountAllowlistCmd(),
+		NewSetMaintenanceModeCmd(),
+		NewPauseCmd(),
+		NewUnpauseCmd(),
 	)
 
 	return txCmd
@@ -220,6 +237,363 @@ func NewUpdateStakingParamsCmd() *cobra.Command {
 	return cmd
 }
 
+func NewUpdateSlashingParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-slashing-params [signedBlocksWindow] [minSignedPerWindow] [downtimeJailDuration] [slashFractionDoubleSign] [slashFractionDowntime]",
+		Short: "update the slashing module params",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signedBlocksWindow, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("signedBlocksWindow strconv.ParseInt failed: %w", err)
+			}
+
+			minSignedPerWindow, err := math.LegacyNewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("minSignedPerWindow math.LegacyNewDecFromStr failed: %w", err)
+			}
+
+			downtimeJailDuration, err := time.ParseDuration(args[2])
+			if err != nil {
+				return fmt.Errorf("downtimeJailDuration time.ParseDuration failed: %w", err)
+			}
+
+			slashFractionDoubleSign, err := math.LegacyNewDecFromStr(args[3])
+			if err != nil {
+				return fmt.Errorf("slashFractionDoubleSign math.LegacyNewDecFromStr failed: %w", err)
+			}
+
+			slashFractionDowntime, err := math.LegacyNewDecFromStr(args[4])
+			if err != nil {
+				return fmt.Errorf("slashFractionDowntime math.LegacyNewDecFromStr failed: %w", err)
+			}
+
+			msg := &poa.MsgUpdateSlashingParams{
+				Sender: clientCtx.GetFromAddress().String(),
+				Params: poa.SlashingParams{
+					SignedBlocksWindow:      signedBlocksWindow,
+					MinSignedPerWindow:      minSignedPerWindow,
+					DowntimeJailDuration:    downtimeJailDuration,
+					SlashFractionDoubleSign: slashFractionDoubleSign,
+					SlashFractionDowntime:   slashFractionDowntime,
+				},
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewUpdateParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params [path/to/params.json]",
+		Short: "update the poa module params (all must be supplied)",
+		Example: fmt.Sprintf(`$ %s tx poa update-params params.json --from admin
+
+Where params.json contains:
+
+{
+	"allow_validator_self_exit": true,
+	"hybrid_mode": false,
+	"admin_power_weight": "0.500000000000000000",
+	"max_delegated_power": "0",
+	"blocked_msg_type_urls": [
+		"/cosmos.staking.v1beta1.MsgDelegate",
+		"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward"
+	],
+	"commission_limits": {
+		"rate": {"floor": "0.100000000000000000", "ceil": "0.500000000000000000"},
+		"max_rate": {"floor": "0.000000000000000000", "ceil": "1.000000000000000000"},
+		"max_change_rate": {"floor": "0.000000000000000000", "ceil": "0.050000000000000000"}
+	},
+	"account_allowlist": {
+		"enabled": true,
+		"allow_operators": true
+	},
+	"equal_power": {
+		"enabled": false,
+		"power": "0"
+	},
+	"fee_distribution": {
+		"mode": "FEE_DISTRIBUTION_MODE_POWER",
+		"treasury_address": "cosmos1...",
+		"treasury_share": "0.100000000000000000"
+	},
+	"virtual_power": {
+		"enabled": false,
+		"denom": ""
+	},
+	"downtime_policy": {
+		"action": "DOWNTIME_ACTION_UNSPECIFIED",
+		"min_signed_per_window": "0.500000000000000000",
+		"power_reduction": "0.500000000000000000"
+	}
+}`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var params poa.Params
+			if err := clientCtx.Codec.UnmarshalJSON(contents, &params); err != nil {
+				return fmt.Errorf("failed to parse params: %w", err)
+			}
+
+			if err := params.Validate(); err != nil {
+				return fmt.Errorf("params.Validate failed: %w", err)
+			}
+
+			msg := &poa.MsgUpdateParams{
+				Sender: clientCtx.GetFromAddress().String(),
+				Params: params,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRegisterOrganizationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-organization [id] [name] [max-power-share]",
+		Short:   "register or update an organization of validators with a maximum share of the total power",
+		Example: fmt.Sprintf(`$ %s tx poa register-organization acme "Acme Corp" 0.33 --from admin`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxPowerShare, err := math.LegacyNewDecFromStr(args[2])
+			if err != nil {
+				return fmt.Errorf("maxPowerShare math.LegacyNewDecFromStr failed: %w", err)
+			}
+
+			msg := &poa.MsgRegisterOrganization{
+				Sender:       clientCtx.GetFromAddress().String(),
+				Organization: poa.NewOrganization(args[0], args[1], maxPowerShare),
+			}
+
+			if err := msg.Validate(); err != nil {
+				return fmt.Errorf("msg.Validate failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetValidatorOrganizationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-validator-organization [validator] [organization-id]",
+		Short: "assign a validator to an organization (an empty organization-id removes the assignment)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validator := args[0]
+			_, err = sdk.ValAddressFromBech32(validator)
+			if err != nil {
+				return fmt.Errorf("ValAddressFromBech32 failed: %w", err)
+			}
+
+			msg := &poa.MsgSetValidatorOrganization{
+				Sender:           clientCtx.GetFromAddress().String(),
+				ValidatorAddress: validator,
+				OrganizationId:   args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewUpdateAccountAllowlistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-account-allowlist",
+		Short: "add or remove accounts and bech32 address prefixes of the account allowlist",
+		Example: fmt.Sprintf(`$ %s tx poa update-account-allowlist --add-accounts cosmos1...,cosmos1... --remove-prefixes cosmos1qqq --from admin`,
+			version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &poa.MsgUpdateAccountAllowlist{
+				Sender: clientCtx.GetFromAddress().String(),
+			}
+
+			for flagName, list := range map[string]*[]string{
+				FlagAddAccounts:    &msg.AddAccounts,
+				FlagRemoveAccounts: &msg.RemoveAccounts,
+				FlagAddPrefixes:    &msg.AddPrefixes,
+				FlagRemovePrefixes: &msg.RemovePrefixes,
+			} {
+				if *list, err = cmd.Flags().GetStringSlice(flagName); err != nil {
+					return fmt.Errorf("get %s flag failed: %w", flagName, err)
+				}
+			}
+
+			if err := msg.Validate(clientCtx.InterfaceRegistry.SigningContext().AddressCodec()); err != nil {
+				return fmt.Errorf("msg.Validate failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().StringSlice(FlagAddAccounts, nil, "accounts to add to the allowlist")
+	cmd.Flags().StringSlice(FlagRemoveAccounts, nil, "accounts to remove from the allowlist")
+	cmd.Flags().StringSlice(FlagAddPrefixes, nil, "bech32 address prefixes to add to the allowlist")
+	cmd.Flags().StringSlice(FlagRemovePrefixes, nil, "bech32 address prefixes to remove from the allowlist")
+
+	return cmd
+}
+
+func NewSetMaintenanceModeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-maintenance-mode [enabled]",
+		Short: "enable or disable maintenance mode, where only the POA transactions of the admin are accepted",
+		Example: fmt.Sprintf(`$ %s tx poa set-maintenance-mode true --expiry-height 1000 --allowed-msg-type-urls /cosmos.bank.v1beta1.MsgSend --from admin`,
+			version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return fmt.Errorf("strconv.ParseBool failed: %w", err)
+			}
+
+			expiryHeight, err := cmd.Flags().GetInt64(FlagExpiryHeight)
+			if err != nil {
+				return fmt.Errorf("get %s flag failed: %w", FlagExpiryHeight, err)
+			}
+
+			allowedMsgTypeURLs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgTypeURLs)
+			if err != nil {
+				return fmt.Errorf("get %s flag failed: %w", FlagAllowedMsgTypeURLs, err)
+			}
+
+			msg := &poa.MsgSetMaintenanceMode{
+				Sender: clientCtx.GetFromAddress().String(),
+				MaintenanceMode: poa.MaintenanceMode{
+					Enabled:            enabled,
+					ExpiryHeight:       expiryHeight,
+					AllowedMsgTypeUrls: allowedMsgTypeURLs,
+				},
+			}
+
+			if err := msg.Validate(); err != nil {
+				return fmt.Errorf("msg.Validate failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Int64(FlagExpiryHeight, 0, "block height maintenance mode ends at, 0 for no expiry")
+	cmd.Flags().StringSlice(FlagAllowedMsgTypeURLs, nil, "message type URLs accepted from any account during maintenance mode")
+
+	return cmd
+}
+
+func NewPauseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause",
+		Short: "pause the validator set operations of the module (guardian only)",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &poa.MsgPause{
+				Guardian: clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewUnpauseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause [guardian]",
+		Short: "resume the validator set operations of the module, the tx must be signed by the admin and the guardian",
+		Example: fmt.Sprintf(`$ %s tx poa unpause cosmos1... --from admin --generate-only > unpause.json
+$ %s tx sign unpause.json --from admin > unpause-admin.json
+$ %s tx sign unpause-admin.json --from guardian > unpause-signed.json
+$ %s tx broadcast unpause-signed.json`, version.AppName, version.AppName, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return fmt.Errorf("AccAddressFromBech32 failed: %w", err)
+			}
+
+			msg := &poa.MsgUnpause{
+				Authority: clientCtx.GetFromAddress().String(),
+				Guardian:  args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCreateValidatorCmd returns a CLI command handler for creating a MsgCreateValidator transaction.
 func NewCreateValidatorCmd(ac address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -244,7 +618,8 @@ Where validator.json contains:
 	"commission-rate": "0.1",
 	"commission-max-rate": "0.2",
 	"commission-max-change-rate": "0.01",
-	"min-self-delegation": "1" # ignored
+	"min-self-delegation": "1", # ignored
+	"organization-id": "optional id of a registered organization"
 }
 
 where we can get the pubkey using "%s tendermint show-validator"
@@ -309,6 +684,7 @@ func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *fl
 	if err != nil {
 		return txf, nil, err
 	}
+	msg.OrganizationId = val.OrganizationID
 	if err := msg.Validate(valAc); err != nil {
 		return txf, nil, err
 	}
@@ -29,6 +29,7 @@ type validator struct {
 	Details           string
 	CommissionRates   types.CommissionRates
 	MinSelfDelegation math.Int
+	OrganizationID    string
 }
 
 func parseAndValidateValidatorJSON(cdc codec.Codec, path string) (validator, error) {
@@ -44,6 +45,7 @@ func parseAndValidateValidatorJSON(cdc codec.Codec, path string) (validator, err
 		CommissionMaxRate   string          `json:"commission-max-rate"`
 		CommissionMaxChange string          `json:"commission-max-change-rate"`
 		MinSelfDelegation   string          `json:"min-self-delegation"`
+		OrganizationID      string          `json:"organization-id,omitempty"`
 	}
 
 	contents, err := os.ReadFile(path)
@@ -100,6 +102,7 @@ func parseAndValidateValidatorJSON(cdc codec.Codec, path string) (validator, err
 		Details:           v.Details,
 		CommissionRates:   commissionRates,
 		MinSelfDelegation: minSelfDelegation,
+		OrganizationID:    v.OrganizationID,
 	}, nil
 }
 
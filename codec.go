@@ -16,6 +16,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveValidator{}, "poa/MsgRemoveValidator")
 	legacy.RegisterAminoMsg(cdc, &MsgRemovePending{}, "poa/MsgRemovePending")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateStakingParams{}, "poa/MsgUpdateStakingParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateSlashingParams{}, "poa/MsgUpdateSlashingParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "poa/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterOrganization{}, "poa/MsgRegisterOrganization")
+	legacy.RegisterAminoMsg(cdc, &MsgSetValidatorOrganization{}, "poa/MsgSetValidatorOrganization")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateAccountAllowlist{}, "poa/MsgUpdateAccountAllowlist")
+	legacy.RegisterAminoMsg(cdc, &MsgSetMaintenanceMode{}, "poa/MsgSetMaintenanceMode")
+	legacy.RegisterAminoMsg(cdc, &MsgPause{}, "poa/MsgPause")
+	legacy.RegisterAminoMsg(cdc, &MsgUnpause{}, "poa/MsgUnpause")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
@@ -26,6 +34,16 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgRemoveValidator{},
 		&MsgRemovePending{},
 		&MsgUpdateStakingParams{},
+		&MsgUpdateSlashingParams{},
+		&MsgUpdateParams{},
+		&MsgRegisterOrganization{},
+		&MsgSetValidatorOrganization{},
+		&MsgUpdateAccountAllowlist{},
+		&MsgSetMaintenanceMode{},
+		&MsgPause{},
+		&MsgUnpause{},
+		// recorded in the audit log only, it is not handled by the msg server.
+		&AuthorityChange{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -19,13 +19,22 @@ func TestCodecRegisterInterfaces(t *testing.T) {
 
 	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
 
-	require.Len(t, impls, 5)
+	require.Len(t, impls, 14)
 	require.ElementsMatch(t, []string{
 		prefix + "MsgSetPower",
 		prefix + "MsgCreateValidator",
 		prefix + "MsgRemoveValidator",
 		prefix + "MsgRemovePending",
 		prefix + "MsgUpdateStakingParams",
+		prefix + "MsgUpdateSlashingParams",
+		prefix + "MsgUpdateParams",
+		prefix + "MsgRegisterOrganization",
+		prefix + "MsgSetValidatorOrganization",
+		prefix + "MsgUpdateAccountAllowlist",
+		prefix + "MsgSetMaintenanceMode",
+		prefix + "MsgPause",
+		prefix + "MsgUnpause",
+		prefix + "AuthorityChange",
 	}, impls)
 }
 
@@ -0,0 +1,90 @@
+package poa
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// DefaultCommissionLimits returns commission limits allowing any valid commission rate.
+func DefaultCommissionLimits() CommissionLimits {
+	return CommissionLimits{
+		Rate:          NewDecRange(math.LegacyZeroDec(), math.LegacyOneDec()),
+		MaxRate:       NewDecRange(math.LegacyZeroDec(), math.LegacyOneDec()),
+		MaxChangeRate: NewDecRange(math.LegacyZeroDec(), math.LegacyOneDec()),
+	}
+}
+
+// NewDecRange returns the inclusive range between floor and ceil.
+func NewDecRange(floor, ceil math.LegacyDec) DecRange {
+	return DecRange{
+		Floor: floor,
+		Ceil:  ceil,
+	}
+}
+
+// Validate checks the range is set within [0, 1] and its floor is not greater than its ceil.
+func (r DecRange) Validate() error {
+	if r.Floor.IsNil() || r.Ceil.IsNil() {
+		return fmt.Errorf("range floor and ceil cannot be nil")
+	}
+
+	if r.Floor.IsNegative() || r.Ceil.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("range [%s, %s] must be within 0 and 1", r.Floor, r.Ceil)
+	}
+
+	if r.Floor.GT(r.Ceil) {
+		return fmt.Errorf("range floor %s is greater than its ceil %s", r.Floor, r.Ceil)
+	}
+
+	return nil
+}
+
+// Contains returns true if the value is within the range. A nil value is never within it.
+func (r DecRange) Contains(value math.LegacyDec) bool {
+	return !value.IsNil() && value.GTE(r.Floor) && value.LTE(r.Ceil)
+}
+
+// Validate performs basic validation of the commission limits.
+func (cl CommissionLimits) Validate() error {
+	if err := cl.Rate.Validate(); err != nil {
+		return fmt.Errorf("invalid commission rate limit: %w", err)
+	}
+
+	if err := cl.MaxRate.Validate(); err != nil {
+		return fmt.Errorf("invalid commission max rate limit: %w", err)
+	}
+
+	if err := cl.MaxChangeRate.Validate(); err != nil {
+		return fmt.Errorf("invalid commission max change rate limit: %w", err)
+	}
+
+	return nil
+}
+
+// CheckRates returns an error if a rate of a new validator commission is outside of the limits.
+func (cl CommissionLimits) CheckRates(rate, maxRate, maxChangeRate math.LegacyDec) error {
+	if err := cl.CheckRate(rate); err != nil {
+		return err
+	}
+
+	if !cl.MaxRate.Contains(maxRate) {
+		return errorsmod.Wrapf(ErrCommissionOutOfRange, "max rate %v is not between %v and %v", maxRate, cl.MaxRate.Floor, cl.MaxRate.Ceil)
+	}
+
+	if !cl.MaxChangeRate.Contains(maxChangeRate) {
+		return errorsmod.Wrapf(ErrCommissionOutOfRange, "max change rate %v is not between %v and %v", maxChangeRate, cl.MaxChangeRate.Floor, cl.MaxChangeRate.Ceil)
+	}
+
+	return nil
+}
+
+// CheckRate returns an error if the commission rate is outside of the limits.
+func (cl CommissionLimits) CheckRate(rate math.LegacyDec) error {
+	if !cl.Rate.Contains(rate) {
+		return errorsmod.Wrapf(ErrCommissionOutOfRange, "rate %v is not between %v and %v", rate, cl.Rate.Floor, cl.Rate.Ceil)
+	}
+
+	return nil
+}
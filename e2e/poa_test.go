@@ -57,7 +57,7 @@ func testStakingDisabled(t *testing.T, ctx context.Context, chain *cosmos.Cosmos
 	t.Log("\n===== TEST STAKING DISABLED =====")
 	// Normal delegation execution fails
 	txRes, _ := helpers.StakeTokens(t, ctx, chain, acc0, validators[0], "1stake")
-	require.Contains(t, txRes.RawLog, poa.ErrStakingActionNotAllowed.Error())
+	require.Contains(t, txRes.RawLog, poa.ErrMsgTypeBlocked.Error())
 
 	granter := acc1
 	grantee := acc0
@@ -74,14 +74,14 @@ func testStakingDisabled(t *testing.T, ctx context.Context, chain *cosmos.Cosmos
 	// Execute nested message via a wrapped Exec
 	res, err = helpers.ExecuteAuthzExecMsg(t, ctx, chain, grantee, nestedCmd)
 	require.NoError(t, err)
-	require.Contains(t, res.RawLog, poa.ErrStakingActionNotAllowed.Error())
+	require.Contains(t, res.RawLog, poa.ErrMsgTypeBlocked.Error())
 }
 func testWithdrawDelegatorRewardsDisabled(t *testing.T, ctx context.Context, chain *cosmos.CosmosChain, validators []string, acc0, acc1 ibc.Wallet) {
 	t.Log("\n===== TEST WITHDRAW DELEGATOR REWARDS DISABLED =====")
 
 	// Normal withdraw delegation rewards execution fails
 	txRes, _ := helpers.WithdrawDelegatorRewards(t, ctx, chain, acc0, validators[0])
-	require.Contains(t, txRes.RawLog, poa.ErrWithdrawDelegatorRewardsNotAllowed.Error())
+	require.Contains(t, txRes.RawLog, poa.ErrMsgTypeBlocked.Error())
 
 	granter := acc1
 	grantee := acc0
@@ -98,7 +98,7 @@ func testWithdrawDelegatorRewardsDisabled(t *testing.T, ctx context.Context, cha
 	// Execute nested message via a wrapped Exec
 	res, err = helpers.ExecuteAuthzExecMsg(t, ctx, chain, grantee, nestedCmd)
 	require.NoError(t, err)
-	require.Contains(t, res.RawLog, poa.ErrWithdrawDelegatorRewardsNotAllowed.Error())
+	require.Contains(t, res.RawLog, poa.ErrMsgTypeBlocked.Error())
 }
 
 func testRemovePending(t *testing.T, ctx context.Context, chain *cosmos.CosmosChain, admin ibc.Wallet) {
@@ -11,4 +11,19 @@ var (
 	ErrUnsafePower                        = sdkerrors.Register(ModuleName, 4, "unsafe: msg.Power is >30%% of total power, set unsafe=true to override")
 	ErrWithdrawDelegatorRewardsNotAllowed = sdkerrors.Register(ModuleName, 5, "withdraw delegator rewards is not allowed on this chain")
 	ErrValidatorAlreadyPending            = sdkerrors.Register(ModuleName, 6, "this validator is already pending")
+	ErrInvalidOrganization                = sdkerrors.Register(ModuleName, 7, "invalid organization")
+	ErrOrganizationNotFound               = sdkerrors.Register(ModuleName, 8, "organization not found")
+	ErrOrganizationPowerExceeded          = sdkerrors.Register(ModuleName, 9, "organization power exceeds its maximum share of total power")
+	ErrValidatorSelfExitNotAllowed        = sdkerrors.Register(ModuleName, 10, "validators are not allowed to remove themselves from the set")
+	ErrValidatorNotAuthorized             = sdkerrors.Register(ModuleName, 11, "validator is not authorized by the admin to receive delegations")
+	ErrDelegationCapExceeded              = sdkerrors.Register(ModuleName, 12, "delegation exceeds the maximum delegated power of the validator")
+	ErrMsgTypeBlocked                     = sdkerrors.Register(ModuleName, 13, "message type is blocked on this chain")
+	ErrCommissionOutOfRange               = sdkerrors.Register(ModuleName, 14, "commission is outside of the limits of the chain")
+	ErrNestedMsgDepthExceeded             = sdkerrors.Register(ModuleName, 15, "messages are nested deeper than the maximum depth")
+	ErrAccountNotAllowed                  = sdkerrors.Register(ModuleName, 16, "account is not allowed to submit transactions on this chain")
+	ErrMaintenanceMode                    = sdkerrors.Register(ModuleName, 17, "chain is in maintenance mode")
+	ErrPaused                             = sdkerrors.Register(ModuleName, 18, "validator set operations are paused")
+	ErrNotGuardian                        = sdkerrors.Register(ModuleName, 19, "sender is not the guardian")
+	ErrEqualPowerMode                     = sdkerrors.Register(ModuleName, 20, "validator power is managed by the equal power mode")
+	ErrValidatorBanned                    = sdkerrors.Register(ModuleName, 21, "validator is banned for double signing")
 )
@@ -0,0 +1,504 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: strangelove_ventures/poa/v1/fee_distribution.proto
+
+package poa
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeRecipient is an account the POA fee distribution pays the transaction
+// fees to, a validator operator account or the treasury.
+type FeeRecipient struct {
+	// address is the account address of the recipient.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// accrued are the fees allocated to the recipient which are not paid yet,
+	// the fractions of a coin or the fees of a failed payment.
+	Accrued github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=accrued,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"accrued"`
+	// paid are the fees paid to the recipient in total.
+	Paid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=paid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"paid"`
+}
+
+func (m *FeeRecipient) Reset()         { *m = FeeRecipient{} }
+func (m *FeeRecipient) String() string { return proto.CompactTextString(m) }
+func (*FeeRecipient) ProtoMessage()    {}
+func (*FeeRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_906e0da0bbb94a2d, []int{0}
+}
+func (m *FeeRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeRecipient.Merge(m, src)
+}
+func (m *FeeRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeRecipient proto.InternalMessageInfo
+
+func (m *FeeRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FeeRecipient) GetAccrued() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Accrued
+	}
+	return nil
+}
+
+func (m *FeeRecipient) GetPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Paid
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*FeeRecipient)(nil), "strangelove_ventures.poa.v1.FeeRecipient")
+}
+
+func init() {
+	proto.RegisterFile("strangelove_ventures/poa/v1/fee_distribution.proto", fileDescriptor_906e0da0bbb94a2d)
+}
+
+var fileDescriptor_906e0da0bbb94a2d = []byte{
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x4a, 0xfb, 0x40,
+	0x10, 0xc6, 0x93, 0xb6, 0xfc, 0xcb, 0x3f, 0x7a, 0x31, 0xf4, 0x90, 0x56, 0x49, 0x8b, 0x5e, 0x8a,
+	0xd2, 0x2c, 0xa9, 0x08, 0x22, 0x78, 0xb0, 0x8a, 0x0f, 0x50, 0x6f, 0x5e, 0xca, 0x26, 0x19, 0xe3,
+	0xa2, 0xcd, 0x2c, 0xbb, 0x9b, 0x80, 0x6f, 0xe1, 0x23, 0xf4, 0x28, 0x9e, 0x3c, 0xf8, 0x10, 0x3d,
+	0x16, 0x4f, 0x9e, 0x54, 0xda, 0x83, 0x3e, 0x86, 0x34, 0xd9, 0x42, 0x0e, 0x3d, 0x78, 0x49, 0x76,
+	0x76, 0xbe, 0xef, 0xfb, 0x0d, 0x3b, 0x56, 0x5f, 0x2a, 0x41, 0x93, 0x18, 0xee, 0x31, 0x83, 0x51,
+	0x06, 0x89, 0x4a, 0x05, 0x48, 0xc2, 0x91, 0x92, 0xcc, 0x27, 0x37, 0x00, 0xa3, 0x88, 0x49, 0x25,
+	0x58, 0x90, 0x2a, 0x86, 0x89, 0xc7, 0x05, 0x2a, 0xb4, 0xb7, 0xd7, 0x79, 0x3c, 0x8e, 0xd4, 0xcb,
+	0xfc, 0x56, 0x23, 0xc6, 0x18, 0x73, 0x1d, 0x59, 0x9e, 0x0a, 0x4b, 0x6b, 0x8b, 0x8e, 0x59, 0x82,
+	0x24, 0xff, 0xea, 0xab, 0x66, 0x88, 0x72, 0x8c, 0x72, 0x54, 0x68, 0x8b, 0x42, 0xb7, 0xdc, 0xa2,
+	0x22, 0x01, 0x95, 0x40, 0x32, 0x3f, 0x00, 0x45, 0x7d, 0x12, 0x22, 0xd3, 0x03, 0xec, 0x4e, 0x2a,
+	0xd6, 0xe6, 0x25, 0xc0, 0x10, 0x42, 0xc6, 0x19, 0x24, 0xca, 0xee, 0x5b, 0x75, 0x1a, 0x45, 0x02,
+	0xa4, 0x74, 0xcc, 0x8e, 0xd9, 0xfd, 0x3f, 0x70, 0xde, 0x5e, 0x7b, 0x0d, 0x9d, 0x79, 0x56, 0x74,
+	0xae, 0x94, 0x60, 0x49, 0x3c, 0x5c, 0x09, 0x6d, 0x6e, 0xd5, 0x69, 0x18, 0x8a, 0x14, 0x22, 0xa7,
+	0xd2, 0xa9, 0x76, 0x37, 0xfa, 0x3b, 0x9e, 0x36, 0x2c, 0xb1, 0x9e, 0xc6, 0x7a, 0x17, 0x10, 0x9e,
+	0x23, 0x4b, 0x06, 0xc7, 0xd3, 0x8f, 0xb6, 0xf1, 0xfc, 0xd9, 0x3e, 0x88, 0x99, 0xba, 0x4d, 0x03,
+	0x2f, 0xc4, 0xb1, 0x1e, 0x5a, 0xff, 0x7a, 0x32, 0xba, 0x23, 0xea, 0x81, 0x83, 0x5c, 0x79, 0xe4,
+	0xd3, 0xf7, 0xcb, 0xbe, 0x39, 0x5c, 0x61, 0xec, 0xc8, 0xaa, 0x71, 0xca, 0x22, 0xa7, 0x9a, 0xe3,
+	0x9a, 0x6b, 0x71, 0x39, 0xeb, 0x48, 0xb3, 0xba, 0x7f, 0x60, 0x95, 0x40, 0x79, 0xfa, 0x49, 0xed,
+	0x67, 0xd2, 0x36, 0x07, 0xa7, 0xd3, 0xb9, 0x6b, 0xce, 0xe6, 0xae, 0xf9, 0x35, 0x77, 0xcd, 0xc7,
+	0x85, 0x6b, 0xcc, 0x16, 0xae, 0xf1, 0xbe, 0x70, 0x8d, 0xeb, 0xbd, 0x52, 0x68, 0x69, 0x91, 0xbd,
+	0xf2, 0xf2, 0x83, 0x7f, 0xf9, 0x43, 0x1f, 0xfe, 0x0e, 0x00, 0x12, 0x1e, 0x6a, 0x3f, 0x1f, 0x02,
+	0x00, 0x00,
+}
+
+func (this *FeeRecipient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeRecipient)
+	if !ok {
+		that2, ok := that.(FeeRecipient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if len(this.Accrued) != len(that1.Accrued) {
+		return false
+	}
+	for i := range this.Accrued {
+		if !this.Accrued[i].Equal(&that1.Accrued[i]) {
+			return false
+		}
+	}
+	if len(this.Paid) != len(that1.Paid) {
+		return false
+	}
+	for i := range this.Paid {
+		if !this.Paid[i].Equal(&that1.Paid[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *FeeRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Paid) > 0 {
+		for iNdEx := len(m.Paid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Paid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Accrued) > 0 {
+		for iNdEx := len(m.Accrued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accrued[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFeeDistribution(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeDistribution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeDistribution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFeeDistribution(uint64(l))
+	}
+	if len(m.Accrued) > 0 {
+		for _, e := range m.Accrued {
+			l = e.Size()
+			n += 1 + l + sovFeeDistribution(uint64(l))
+		}
+	}
+	if len(m.Paid) > 0 {
+		for _, e := range m.Paid {
+			l = e.Size()
+			n += 1 + l + sovFeeDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFeeDistribution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeDistribution(x uint64) (n int) {
+	return sovFeeDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accrued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accrued = append(m.Accrued, types.DecCoin{})
+			if err := m.Accrued[len(m.Accrued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paid = append(m.Paid, types.Coin{})
+			if err := m.Paid[len(m.Paid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeDistribution(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeDistribution
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeDistribution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeDistribution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeDistribution
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeDistribution
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeDistribution
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeDistribution        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeDistribution          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeDistribution = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,11 +1,344 @@
 package poa
 
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = (*GenesisState)(nil)
+
 // NewGenesisState creates a new genesis state with default values.
 func NewGenesisState() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, val := range gs.Vals {
+		if err := val.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range gs.AuditLog {
+		if err := entry.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	for _, val := range gs.Validators {
+		if err := val.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (v GenesisValidator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+
+	return unpacker.UnpackAny(v.ConsensusPubkey, &pk)
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 func (gs *GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	operators := make(map[string]bool, len(gs.Vals)+len(gs.Validators))
+	consAddrs := make(map[string]bool, len(gs.Vals)+len(gs.Validators))
+
+	if err := validatePendingValidators(gs.Vals, operators, consAddrs); err != nil {
+		return err
+	}
+
+	if err := validateGenesisValidators(gs.Validators, operators, consAddrs); err != nil {
+		return err
+	}
+
+	updated := make(map[string]bool, len(gs.UpdatedValidators))
+	for _, valAddr := range gs.UpdatedValidators {
+		if _, err := sdk.ValAddressFromBech32(valAddr); err != nil {
+			return fmt.Errorf("invalid updated validator address %s: %w", valAddr, err)
+		}
+
+		if updated[valAddr] {
+			return fmt.Errorf("duplicate updated validator found in genesis state: %s", valAddr)
+		}
+		updated[valAddr] = true
+	}
+
+	if err := validateAdminPowers(gs.AdminPowers, gs.Params.HybridMode); err != nil {
+		return err
+	}
+
+	orgs := make(map[string]bool, len(gs.Organizations))
+	for _, org := range gs.Organizations {
+		if err := org.Validate(); err != nil {
+			return err
+		}
+
+		if orgs[org.Id] {
+			return fmt.Errorf("duplicate organization found in genesis state: %s", org.Id)
+		}
+		orgs[org.Id] = true
+	}
+
+	assigned := make(map[string]bool, len(gs.ValidatorOrganizations))
+	for _, vo := range gs.ValidatorOrganizations {
+		if _, err := sdk.ValAddressFromBech32(vo.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid organization validator address %s: %w", vo.ValidatorAddress, err)
+		}
+
+		if !orgs[vo.OrganizationId] {
+			return fmt.Errorf("validator %s is assigned to unknown organization %s", vo.ValidatorAddress, vo.OrganizationId)
+		}
+
+		if assigned[vo.ValidatorAddress] {
+			return fmt.Errorf("validator %s is assigned to more than one organization", vo.ValidatorAddress)
+		}
+		assigned[vo.ValidatorAddress] = true
+	}
+
+	tracked := make(map[string]bool, len(gs.OrganizationPowers))
+	for _, op := range gs.OrganizationPowers {
+		if !orgs[op.OrganizationId] {
+			return fmt.Errorf("power tracked for unknown organization %s", op.OrganizationId)
+		}
+
+		if tracked[op.OrganizationId] {
+			return fmt.Errorf("duplicate organization power found in genesis state: %s", op.OrganizationId)
+		}
+		tracked[op.OrganizationId] = true
+
+		if op.Power < 0 {
+			return fmt.Errorf("organization %s has a negative power: %d", op.OrganizationId, op.Power)
+		}
+	}
+
+	if err := validateAccountAllowlist(gs.AllowedAccounts, gs.AllowedPrefixes); err != nil {
+		return err
+	}
+
+	if err := gs.MaintenanceMode.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateFeeRecipients(gs.FeeRecipients); err != nil {
+		return err
+	}
+
+	if err := validateBannedValidators(gs.BannedValidators, gs.BannedConsensusAddresses); err != nil {
+		return err
+	}
+
+	if err := validateSlashedValidators(gs.SlashedValidators); err != nil {
+		return err
+	}
+
+	if gs.Authorities != nil {
+		if err := gs.Authorities.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if gs.BondedTokens != nil && (gs.BondedTokens.IsNil() || gs.BondedTokens.IsNegative()) {
+		return fmt.Errorf("bonded tokens can not be negative: %s", gs.BondedTokens)
+	}
+
+	return validateAuditLog(gs.AuditLog, gs.AuditLogSequence)
+}
+
+// validateFeeRecipients checks the fee recipients have unique, valid addresses and valid accrued and paid fees.
+func validateFeeRecipients(recipients []FeeRecipient) error {
+	seen := make(map[string]bool, len(recipients))
+	for _, recipient := range recipients {
+		if _, err := sdk.AccAddressFromBech32(recipient.Address); err != nil {
+			return fmt.Errorf("invalid fee recipient address %s: %w", recipient.Address, err)
+		}
+
+		if seen[recipient.Address] {
+			return fmt.Errorf("duplicate fee recipient found in genesis state: %s", recipient.Address)
+		}
+		seen[recipient.Address] = true
+
+		if err := recipient.Accrued.Validate(); err != nil {
+			return fmt.Errorf("fee recipient %s has invalid accrued fees: %w", recipient.Address, err)
+		}
+
+		if err := recipient.Paid.Validate(); err != nil {
+			return fmt.Errorf("fee recipient %s has invalid paid fees: %w", recipient.Address, err)
+		}
+	}
+
+	return nil
+}
+
+// validateBannedValidators checks the banned operator and consensus addresses are unique and valid.
+func validateBannedValidators(operators, consAddrs []string) error {
+	seen := make(map[string]bool, len(operators)+len(consAddrs))
+	for _, operator := range operators {
+		if _, err := sdk.ValAddressFromBech32(operator); err != nil {
+			return fmt.Errorf("invalid banned validator address %s: %w", operator, err)
+		}
+
+		if seen[operator] {
+			return fmt.Errorf("duplicate banned validator found in genesis state: %s", operator)
+		}
+		seen[operator] = true
+	}
+
+	for _, consAddr := range consAddrs {
+		if _, err := sdk.ConsAddressFromBech32(consAddr); err != nil {
+			return fmt.Errorf("invalid banned consensus address %s: %w", consAddr, err)
+		}
+
+		if seen[consAddr] {
+			return fmt.Errorf("duplicate banned consensus address found in genesis state: %s", consAddr)
+		}
+		seen[consAddr] = true
+	}
+
+	return nil
+}
+
+// validateSlashedValidators checks the slashed validator addresses are unique and valid.
+func validateSlashedValidators(operators []string) error {
+	seen := make(map[string]bool, len(operators))
+	for _, operator := range operators {
+		if _, err := sdk.ValAddressFromBech32(operator); err != nil {
+			return fmt.Errorf("invalid slashed validator address %s: %w", operator, err)
+		}
+
+		if seen[operator] {
+			return fmt.Errorf("duplicate slashed validator found in genesis state: %s", operator)
+		}
+		seen[operator] = true
+	}
+
+	return nil
+}
+
+// validatePendingValidators checks the pending validators for valid commission rates and unique, valid keys.
+func validatePendingValidators(vals []Validator, operators, consAddrs map[string]bool) error {
+	for _, val := range vals {
+		if err := validateValidatorKeys(val.OperatorAddress, val.ConsensusPubkey, operators, consAddrs); err != nil {
+			return err
+		}
+
+		if err := val.Commission.CommissionRates.Validate(); err != nil {
+			return fmt.Errorf("validator %s has invalid commission: %w", val.OperatorAddress, err)
+		}
+	}
+
+	return nil
+}
+
+// validateGenesisValidators checks the genesis validators for a valid description, commission rates and power,
+// and unique, valid keys.
+func validateGenesisValidators(vals []GenesisValidator, operators, consAddrs map[string]bool) error {
+	for _, val := range vals {
+		if err := validateValidatorKeys(val.OperatorAddress, val.ConsensusPubkey, operators, consAddrs); err != nil {
+			return err
+		}
+
+		if _, err := val.Description.EnsureLength(); err != nil {
+			return fmt.Errorf("validator %s has invalid description: %w", val.OperatorAddress, err)
+		}
+
+		if err := val.Commission.Validate(); err != nil {
+			return fmt.Errorf("validator %s has invalid commission: %w", val.OperatorAddress, err)
+		}
+
+		if val.Power < 1_000_000 {
+			return fmt.Errorf("validator %s: %w", val.OperatorAddress, ErrPowerBelowMinimum)
+		}
+	}
+
+	return nil
+}
+
+// validateValidatorKeys checks the operator address and consensus pubkey are valid and were not seen before.
+func validateValidatorKeys(operator string, pkAny *codectypes.Any, operators, consAddrs map[string]bool) error {
+	if _, err := sdk.ValAddressFromBech32(operator); err != nil {
+		return fmt.Errorf("invalid validator address %s: %w", operator, err)
+	}
+
+	if operators[operator] {
+		return fmt.Errorf("duplicate validator found in genesis state: %s", operator)
+	}
+	operators[operator] = true
+
+	if pkAny == nil {
+		return fmt.Errorf("validator %s has no consensus pubkey", operator)
+	}
+
+	pk, ok := pkAny.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return fmt.Errorf("validator %s consensus pubkey could not be unpacked, got %T", operator, pkAny.GetCachedValue())
+	}
+
+	consAddr := sdk.ConsAddress(pk.Address()).String()
+	if consAddrs[consAddr] {
+		return fmt.Errorf("duplicate consensus pubkey found in genesis state: %s (%s)", consAddr, operator)
+	}
+	consAddrs[consAddr] = true
+
+	return nil
+}
+
+// validateAdminPowers checks the admin powers are only set in hybrid mode, with unique, valid validator addresses.
+func validateAdminPowers(powers []ValidatorPower, hybridMode bool) error {
+	if len(powers) > 0 && !hybridMode {
+		return fmt.Errorf("admin powers are only allowed in hybrid mode")
+	}
+
+	seen := make(map[string]bool, len(powers))
+	for _, vp := range powers {
+		if _, err := sdk.ValAddressFromBech32(vp.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid admin power validator address %s: %w", vp.ValidatorAddress, err)
+		}
+
+		if seen[vp.ValidatorAddress] {
+			return fmt.Errorf("duplicate admin power found in genesis state: %s", vp.ValidatorAddress)
+		}
+		seen[vp.ValidatorAddress] = true
+	}
+
+	return nil
+}
+
+// validateAuditLog checks the audit log entries are unique and below the next sequence id.
+func validateAuditLog(entries []AuditLogEntry, sequence uint64) error {
+	ids := make(map[uint64]bool, len(entries))
+	for _, entry := range entries {
+		if entry.Id >= sequence {
+			return fmt.Errorf("audit log entry %d is not below the audit log sequence %d", entry.Id, sequence)
+		}
+
+		if ids[entry.Id] {
+			return fmt.Errorf("duplicate audit log entry found in genesis state: %d", entry.Id)
+		}
+		ids[entry.Id] = true
+
+		if _, err := sdk.AccAddressFromBech32(entry.Signer); err != nil {
+			return fmt.Errorf("audit log entry %d has an invalid signer %s: %w", entry.Id, entry.Signer, err)
+		}
+
+		if entry.ValidatorAddress != "" {
+			if _, err := sdk.ValAddressFromBech32(entry.ValidatorAddress); err != nil {
+				return fmt.Errorf("audit log entry %d has an invalid validator address %s: %w", entry.Id, entry.ValidatorAddress, err)
+			}
+		}
+	}
+
 	return nil
 }
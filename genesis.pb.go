@@ -4,7 +4,10 @@
 package poa
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,7 +29,61 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the poa module's genesis state.
 type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// vals are the validators pending admin approval.
 	Vals []Validator `protobuf:"bytes,2,rep,name=vals,proto3" json:"vals"`
+	// cached_block_power is the total power of the previous block.
+	CachedBlockPower uint64 `protobuf:"varint,3,opt,name=cached_block_power,json=cachedBlockPower,proto3" json:"cached_block_power,omitempty"`
+	// absolute_changed_in_block_power is the absolute power changed in the
+	// current block.
+	AbsoluteChangedInBlockPower uint64 `protobuf:"varint,4,opt,name=absolute_changed_in_block_power,json=absoluteChangedInBlockPower,proto3" json:"absolute_changed_in_block_power,omitempty"`
+	// updated_validators are the operator addresses of validators whose power
+	// was updated in the current block.
+	UpdatedValidators []string `protobuf:"bytes,5,rep,name=updated_validators,json=updatedValidators,proto3" json:"updated_validators,omitempty"`
+	// organizations are the registered validator organizations.
+	Organizations []Organization `protobuf:"bytes,6,rep,name=organizations,proto3" json:"organizations"`
+	// validator_organizations are the validator to organization assignments.
+	ValidatorOrganizations []ValidatorOrganization `protobuf:"bytes,7,rep,name=validator_organizations,json=validatorOrganizations,proto3" json:"validator_organizations"`
+	// audit_log are the recorded administrative actions.
+	AuditLog []AuditLogEntry `protobuf:"bytes,8,rep,name=audit_log,json=auditLog,proto3" json:"audit_log"`
+	// audit_log_sequence is the id of the next audit log entry.
+	AuditLogSequence uint64 `protobuf:"varint,9,opt,name=audit_log_sequence,json=auditLogSequence,proto3" json:"audit_log_sequence,omitempty"`
+	// validators are installed into the active set at genesis with an explicit
+	// power, without requiring gentxs.
+	Validators []GenesisValidator `protobuf:"bytes,10,rep,name=validators,proto3" json:"validators"`
+	// admin_powers are the admin assigned powers of the validators in hybrid
+	// mode.
+	AdminPowers []ValidatorPower `protobuf:"bytes,11,rep,name=admin_powers,json=adminPowers,proto3" json:"admin_powers"`
+	// allowed_accounts are the accounts of the account allowlist.
+	AllowedAccounts []string `protobuf:"bytes,12,rep,name=allowed_accounts,json=allowedAccounts,proto3" json:"allowed_accounts,omitempty"`
+	// allowed_prefixes are the bech32 address prefixes of the account
+	// allowlist.
+	AllowedPrefixes []string `protobuf:"bytes,13,rep,name=allowed_prefixes,json=allowedPrefixes,proto3" json:"allowed_prefixes,omitempty"`
+	// maintenance_mode is the maintenance mode of the chain.
+	MaintenanceMode MaintenanceMode `protobuf:"bytes,14,opt,name=maintenance_mode,json=maintenanceMode,proto3" json:"maintenance_mode"`
+	// paused is true when the validator set operations are paused.
+	Paused bool `protobuf:"varint,15,opt,name=paused,proto3" json:"paused,omitempty"`
+	// fee_recipients are the accrued and paid fees of the fee distribution.
+	FeeRecipients []FeeRecipient `protobuf:"bytes,16,rep,name=fee_recipients,json=feeRecipients,proto3" json:"fee_recipients"`
+	// banned_validators are the operator addresses of the validators removed
+	// for double signing.
+	BannedValidators []string `protobuf:"bytes,17,rep,name=banned_validators,json=bannedValidators,proto3" json:"banned_validators,omitempty"`
+	// banned_consensus_addresses are the consensus addresses of the validators
+	// removed for double signing.
+	BannedConsensusAddresses []string `protobuf:"bytes,18,rep,name=banned_consensus_addresses,json=bannedConsensusAddresses,proto3" json:"banned_consensus_addresses,omitempty"`
+	// bonded_tokens are the tracked bonded tokens of the POA validators, unset
+	// if they are not tracked.
+	BondedTokens *cosmossdk_io_math.Int `protobuf:"bytes,19,opt,name=bonded_tokens,json=bondedTokens,proto3,customtype=cosmossdk.io/math.Int" json:"bonded_tokens,omitempty"`
+	// organization_powers are the tracked combined consensus powers of the
+	// validators of each organization.
+	OrganizationPowers []OrganizationPower `protobuf:"bytes,20,rep,name=organization_powers,json=organizationPowers,proto3" json:"organization_powers"`
+	// slashed_validators are the operator addresses of the validators slashed by
+	// x/staking, checked for a tombstone in the next begin block.
+	SlashedValidators []string `protobuf:"bytes,21,rep,name=slashed_validators,json=slashedValidators,proto3" json:"slashed_validators,omitempty"`
+	// authorities are the admin and the guardian the audit log last recorded,
+	// unset if they were not recorded yet.
+	Authorities *Authorities `protobuf:"bytes,22,opt,name=authorities,proto3" json:"authorities,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -62,6 +119,13 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func (m *GenesisState) GetVals() []Validator {
 	if m != nil {
 		return m.Vals
@@ -69,6 +133,387 @@ func (m *GenesisState) GetVals() []Validator {
 	return nil
 }
 
+func (m *GenesisState) GetCachedBlockPower() uint64 {
+	if m != nil {
+		return m.CachedBlockPower
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAbsoluteChangedInBlockPower() uint64 {
+	if m != nil {
+		return m.AbsoluteChangedInBlockPower
+	}
+	return 0
+}
+
+func (m *GenesisState) GetUpdatedValidators() []string {
+	if m != nil {
+		return m.UpdatedValidators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOrganizations() []Organization {
+	if m != nil {
+		return m.Organizations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorOrganizations() []ValidatorOrganization {
+	if m != nil {
+		return m.ValidatorOrganizations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuditLog() []AuditLogEntry {
+	if m != nil {
+		return m.AuditLog
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuditLogSequence() uint64 {
+	if m != nil {
+		return m.AuditLogSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetValidators() []GenesisValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAdminPowers() []ValidatorPower {
+	if m != nil {
+		return m.AdminPowers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowedAccounts() []string {
+	if m != nil {
+		return m.AllowedAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowedPrefixes() []string {
+	if m != nil {
+		return m.AllowedPrefixes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMaintenanceMode() MaintenanceMode {
+	if m != nil {
+		return m.MaintenanceMode
+	}
+	return MaintenanceMode{}
+}
+
+func (m *GenesisState) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *GenesisState) GetFeeRecipients() []FeeRecipient {
+	if m != nil {
+		return m.FeeRecipients
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBannedValidators() []string {
+	if m != nil {
+		return m.BannedValidators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBannedConsensusAddresses() []string {
+	if m != nil {
+		return m.BannedConsensusAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOrganizationPowers() []OrganizationPower {
+	if m != nil {
+		return m.OrganizationPowers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashedValidators() []string {
+	if m != nil {
+		return m.SlashedValidators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuthorities() *Authorities {
+	if m != nil {
+		return m.Authorities
+	}
+	return nil
+}
+
+// ValidatorPower is the admin assigned power of a validator.
+type ValidatorPower struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// power is a micro unit of power (1,000,000 = 1 power), as in MsgSetPower.
+	Power uint64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *ValidatorPower) Reset()         { *m = ValidatorPower{} }
+func (m *ValidatorPower) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPower) ProtoMessage()    {}
+func (*ValidatorPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9ebd7913aa01cfd, []int{1}
+}
+func (m *ValidatorPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPower.Merge(m, src)
+}
+func (m *ValidatorPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPower proto.InternalMessageInfo
+
+func (m *ValidatorPower) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorPower) GetPower() uint64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// GenesisValidator is an active validator with an admin assigned power.
+type GenesisValidator struct {
+	// operator_address is the operator address of the validator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// consensus_pubkey is the consensus public key of the validator.
+	ConsensusPubkey *types.Any `protobuf:"bytes,2,opt,name=consensus_pubkey,json=consensusPubkey,proto3" json:"consensus_pubkey,omitempty"`
+	// description is the description of the validator.
+	Description Description `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
+	// commission are the commission rates of the validator.
+	Commission CommissionRates `protobuf:"bytes,4,opt,name=commission,proto3" json:"commission"`
+	// power is a micro unit of power (1,000,000 = 1 power), as in MsgSetPower.
+	Power uint64 `protobuf:"varint,5,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *GenesisValidator) Reset()         { *m = GenesisValidator{} }
+func (m *GenesisValidator) String() string { return proto.CompactTextString(m) }
+func (*GenesisValidator) ProtoMessage()    {}
+func (*GenesisValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9ebd7913aa01cfd, []int{2}
+}
+func (m *GenesisValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisValidator.Merge(m, src)
+}
+func (m *GenesisValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisValidator proto.InternalMessageInfo
+
+func (m *GenesisValidator) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *GenesisValidator) GetConsensusPubkey() *types.Any {
+	if m != nil {
+		return m.ConsensusPubkey
+	}
+	return nil
+}
+
+func (m *GenesisValidator) GetDescription() Description {
+	if m != nil {
+		return m.Description
+	}
+	return Description{}
+}
+
+func (m *GenesisValidator) GetCommission() CommissionRates {
+	if m != nil {
+		return m.Commission
+	}
+	return CommissionRates{}
+}
+
+func (m *GenesisValidator) GetPower() uint64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// ValidatorOrganization assigns a validator to an organization.
+type ValidatorOrganization struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// organization_id is the id of the organization.
+	OrganizationId string `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+}
+
+func (m *ValidatorOrganization) Reset()         { *m = ValidatorOrganization{} }
+func (m *ValidatorOrganization) String() string { return proto.CompactTextString(m) }
+func (*ValidatorOrganization) ProtoMessage()    {}
+func (*ValidatorOrganization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9ebd7913aa01cfd, []int{3}
+}
+func (m *ValidatorOrganization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorOrganization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorOrganization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorOrganization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorOrganization.Merge(m, src)
+}
+func (m *ValidatorOrganization) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorOrganization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorOrganization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorOrganization proto.InternalMessageInfo
+
+func (m *ValidatorOrganization) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorOrganization) GetOrganizationId() string {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return ""
+}
+
+// OrganizationPower is the combined consensus power of the validators of an
+// organization.
+type OrganizationPower struct {
+	// organization_id is the id of the organization.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// power is the combined consensus power of the organization's validators.
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *OrganizationPower) Reset()         { *m = OrganizationPower{} }
+func (m *OrganizationPower) String() string { return proto.CompactTextString(m) }
+func (*OrganizationPower) ProtoMessage()    {}
+func (*OrganizationPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9ebd7913aa01cfd, []int{4}
+}
+func (m *OrganizationPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrganizationPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrganizationPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrganizationPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationPower.Merge(m, src)
+}
+func (m *OrganizationPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrganizationPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationPower proto.InternalMessageInfo
+
+func (m *OrganizationPower) GetOrganizationId() string {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return ""
+}
+
+func (m *OrganizationPower) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
 // PowerCache is a cached block or absolute change in power for ibc-go validations.
 type PowerCache struct {
 	Power uint64 `protobuf:"varint,1,opt,name=power,proto3" json:"power,omitempty"`
@@ -78,7 +523,7 @@ func (m *PowerCache) Reset()         { *m = PowerCache{} }
 func (m *PowerCache) String() string { return proto.CompactTextString(m) }
 func (*PowerCache) ProtoMessage()    {}
 func (*PowerCache) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9ebd7913aa01cfd, []int{1}
+	return fileDescriptor_d9ebd7913aa01cfd, []int{5}
 }
 func (m *PowerCache) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -116,6 +561,10 @@ func (m *PowerCache) GetPower() uint64 {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "strangelove_ventures.poa.v1.GenesisState")
+	proto.RegisterType((*ValidatorPower)(nil), "strangelove_ventures.poa.v1.ValidatorPower")
+	proto.RegisterType((*GenesisValidator)(nil), "strangelove_ventures.poa.v1.GenesisValidator")
+	proto.RegisterType((*ValidatorOrganization)(nil), "strangelove_ventures.poa.v1.ValidatorOrganization")
+	proto.RegisterType((*OrganizationPower)(nil), "strangelove_ventures.poa.v1.OrganizationPower")
 	proto.RegisterType((*PowerCache)(nil), "strangelove_ventures.poa.v1.PowerCache")
 }
 
@@ -124,25 +573,74 @@ func init() {
 }
 
 var fileDescriptor_d9ebd7913aa01cfd = []byte{
-	// 273 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2c, 0x2e, 0x29, 0x4a,
-	0xcc, 0x4b, 0x4f, 0xcd, 0xc9, 0x2f, 0x4b, 0x8d, 0x2f, 0x4b, 0xcd, 0x2b, 0x29, 0x2d, 0x4a, 0x2d,
-	0xd6, 0x2f, 0xc8, 0x4f, 0xd4, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xc6, 0xa6, 0x54, 0xaf, 0x20, 0x3f, 0x51, 0xaf, 0xcc,
-	0x50, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xac, 0x4e, 0x1f, 0xc4, 0x82, 0x68, 0x91, 0x12, 0x4c,
-	0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x50, 0x21, 0x6d, 0x7c, 0x16, 0x96, 0x25, 0xe6, 0x64,
-	0xa6, 0x24, 0x96, 0xe4, 0x17, 0x41, 0x15, 0x6b, 0xe0, 0x53, 0x5c, 0x90, 0x58, 0x94, 0x98, 0x0b,
-	0x75, 0x9c, 0x94, 0x0a, 0x3e, 0x95, 0x25, 0x15, 0x10, 0x55, 0x4a, 0x01, 0x5c, 0x3c, 0xee, 0x10,
-	0x3f, 0x05, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x39, 0x70, 0xb1, 0x94, 0x25, 0xe6, 0x14, 0x4b, 0x30,
-	0x29, 0x30, 0x6b, 0x70, 0x1b, 0xa9, 0xe9, 0xe1, 0xf1, 0xa1, 0x5e, 0x18, 0xcc, 0x6d, 0x4e, 0x2c,
-	0x27, 0xee, 0xc9, 0x33, 0x04, 0x81, 0x75, 0x2a, 0x99, 0x73, 0x71, 0x05, 0xe4, 0x97, 0xa7, 0x16,
-	0x39, 0x27, 0x26, 0x67, 0xa4, 0x0a, 0x89, 0x70, 0xb1, 0x16, 0x80, 0x78, 0x12, 0x8c, 0x0a, 0x8c,
-	0x1a, 0x2c, 0x41, 0x10, 0x8e, 0x95, 0x70, 0xd7, 0xf3, 0x0d, 0x5a, 0x7c, 0x20, 0xb7, 0x20, 0x94,
-	0x3a, 0xd9, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13,
-	0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x72, 0x7a, 0x66,
-	0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x92, 0x83, 0x74, 0x91, 0x7d, 0x95, 0xc4,
-	0x06, 0xf6, 0x90, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x40, 0xb0, 0xf7, 0x94, 0xc0, 0x01, 0x00,
-	0x00,
+	// 1058 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdf, 0x6e, 0x1b, 0xc5,
+	0x17, 0x8e, 0xf3, 0xef, 0x17, 0x8f, 0x93, 0xd8, 0x99, 0xfc, 0xf9, 0x2d, 0xa9, 0xe4, 0x58, 0x29,
+	0x22, 0x4e, 0x53, 0xaf, 0xd5, 0x70, 0x81, 0x84, 0x40, 0x22, 0x49, 0x01, 0x05, 0x1a, 0x61, 0x6d,
+	0x4a, 0x25, 0x90, 0xd0, 0x6a, 0xbc, 0x7b, 0x62, 0x8f, 0xe2, 0x9d, 0xd9, 0xee, 0xcc, 0xba, 0x35,
+	0x8f, 0xc0, 0x15, 0x8f, 0xc2, 0x45, 0x1f, 0xa2, 0xe2, 0xaa, 0x42, 0x5c, 0x20, 0x2e, 0x2a, 0x94,
+	0x5c, 0xc0, 0x63, 0xa0, 0x9d, 0x9d, 0xb5, 0x67, 0x83, 0xb5, 0x98, 0x1b, 0xcb, 0x73, 0xce, 0x77,
+	0xbe, 0x33, 0x73, 0xce, 0x77, 0x66, 0x16, 0x1d, 0x0a, 0x19, 0x11, 0xd6, 0x83, 0x01, 0x1f, 0x82,
+	0x3b, 0x04, 0x26, 0xe3, 0x08, 0x44, 0x3b, 0xe4, 0xa4, 0x3d, 0x7c, 0xd4, 0xee, 0x01, 0x03, 0x41,
+	0x85, 0x1d, 0x46, 0x5c, 0x72, 0x7c, 0x6f, 0x1a, 0xd4, 0x0e, 0x39, 0xb1, 0x87, 0x8f, 0x76, 0xb7,
+	0x7a, 0xbc, 0xc7, 0x15, 0xae, 0x9d, 0xfc, 0x4b, 0x43, 0x76, 0x37, 0x48, 0x40, 0x19, 0x6f, 0xab,
+	0x5f, 0x6d, 0x7a, 0xc7, 0xe3, 0x22, 0xe0, 0xc2, 0x4d, 0xb1, 0xe9, 0x22, 0x73, 0xf5, 0x38, 0xef,
+	0x0d, 0xa0, 0xad, 0x56, 0xdd, 0xf8, 0xaa, 0x4d, 0xd8, 0x48, 0xbb, 0x8e, 0x8a, 0xb6, 0x39, 0x24,
+	0x03, 0xea, 0x13, 0xc9, 0x23, 0x0d, 0x6e, 0x16, 0x81, 0x43, 0x12, 0x91, 0x20, 0xcb, 0xf8, 0x6e,
+	0x11, 0x52, 0xbe, 0xd4, 0x28, 0xbb, 0x08, 0xc5, 0xa3, 0x1e, 0x61, 0xf4, 0x7b, 0x22, 0x29, 0x67,
+	0x1a, 0x7f, 0x50, 0x84, 0x27, 0xb1, 0x4f, 0xa5, 0x06, 0xb6, 0x8a, 0x80, 0x01, 0xa1, 0x4c, 0x02,
+	0x23, 0xcc, 0x03, 0x0d, 0x3f, 0x2e, 0x82, 0x5f, 0x01, 0xb8, 0x3e, 0x15, 0x32, 0xa2, 0xdd, 0x78,
+	0xb2, 0x97, 0xfd, 0xbf, 0x2a, 0x68, 0xf5, 0xf3, 0xb4, 0x8d, 0x97, 0x92, 0x48, 0xc0, 0x27, 0x68,
+	0x39, 0x2d, 0x81, 0x55, 0x6a, 0x94, 0x9a, 0x95, 0xe3, 0xfb, 0x76, 0x41, 0x5b, 0xed, 0x8e, 0x82,
+	0x9e, 0x2e, 0xbe, 0x7e, 0xbb, 0x37, 0xe7, 0xe8, 0x40, 0xfc, 0x09, 0x5a, 0x1c, 0x92, 0x81, 0xb0,
+	0xe6, 0x1b, 0x0b, 0xcd, 0xca, 0xf1, 0x7b, 0x85, 0x04, 0xcf, 0xb2, 0xde, 0x68, 0x0e, 0x15, 0x89,
+	0x1f, 0x22, 0xec, 0x11, 0xaf, 0x0f, 0xbe, 0xdb, 0x1d, 0x70, 0xef, 0xda, 0x0d, 0xf9, 0x0b, 0x88,
+	0xac, 0x85, 0x46, 0xa9, 0xb9, 0xe8, 0xd4, 0x52, 0xcf, 0x69, 0xe2, 0xe8, 0x24, 0x76, 0xfc, 0x18,
+	0xed, 0x91, 0xae, 0xe0, 0x83, 0x58, 0x82, 0xeb, 0xf5, 0x93, 0x54, 0xbe, 0x4b, 0x59, 0x2e, 0x74,
+	0x51, 0x85, 0xde, 0xcb, 0x60, 0x67, 0x29, 0xea, 0x9c, 0x19, 0x2c, 0x2d, 0x84, 0xe3, 0xd0, 0x27,
+	0x12, 0x7c, 0x77, 0x2c, 0x18, 0x61, 0x2d, 0x35, 0x16, 0x9a, 0x65, 0x67, 0x43, 0x7b, 0xc6, 0xbb,
+	0x15, 0xf8, 0x6b, 0xb4, 0x66, 0xb6, 0x56, 0x58, 0xcb, 0xea, 0xb4, 0x87, 0x85, 0xa7, 0xfd, 0xca,
+	0x88, 0xd0, 0x07, 0xce, 0xb3, 0xe0, 0xe7, 0xe8, 0xff, 0xe3, 0xec, 0x6e, 0x3e, 0xc1, 0xff, 0x54,
+	0x82, 0xe3, 0xd9, 0xca, 0x39, 0x25, 0xd3, 0xce, 0x70, 0x9a, 0x53, 0xe0, 0x0b, 0x54, 0x56, 0xa2,
+	0x73, 0x07, 0xbc, 0x67, 0xad, 0xa8, 0x24, 0x0f, 0x0a, 0x93, 0x9c, 0x24, 0xe8, 0x27, 0xbc, 0xf7,
+	0x29, 0x93, 0xd1, 0x48, 0x93, 0xaf, 0x10, 0x6d, 0x4c, 0x7a, 0x37, 0xa6, 0x73, 0x05, 0x3c, 0x8f,
+	0x81, 0x79, 0x60, 0x95, 0xd3, 0xde, 0x65, 0xa8, 0x4b, 0x6d, 0xc7, 0x97, 0x08, 0x19, 0xd5, 0x46,
+	0x2a, 0x7b, 0xab, 0x30, 0xbb, 0x56, 0xeb, 0x5d, 0xe1, 0x18, 0x34, 0xf8, 0x29, 0x5a, 0x25, 0x7e,
+	0x40, 0x59, 0xda, 0x7c, 0x61, 0x55, 0x14, 0xed, 0xd1, 0x6c, 0x95, 0x53, 0x6a, 0xd0, 0xa4, 0x15,
+	0x45, 0xa3, 0x2c, 0x02, 0x1f, 0xa2, 0x1a, 0x19, 0x0c, 0xf8, 0x0b, 0xf0, 0x5d, 0xe2, 0x79, 0x3c,
+	0x66, 0x52, 0x58, 0xab, 0x4a, 0x1e, 0x55, 0x6d, 0x3f, 0xd1, 0x66, 0x13, 0x1a, 0x46, 0x70, 0x45,
+	0x5f, 0x82, 0xb0, 0xd6, 0x72, 0xd0, 0x8e, 0x36, 0xe3, 0xef, 0x50, 0xcd, 0x98, 0x64, 0x37, 0xe0,
+	0x3e, 0x58, 0xeb, 0x6a, 0xf2, 0x1e, 0x16, 0xee, 0xf7, 0x62, 0x12, 0x74, 0xc1, 0x7d, 0xd0, 0x1b,
+	0xae, 0x06, 0x79, 0x33, 0xde, 0x49, 0xc6, 0x39, 0x16, 0xe0, 0x5b, 0xd5, 0x46, 0xa9, 0xb9, 0xe2,
+	0xe8, 0x15, 0x7e, 0x86, 0xd6, 0x93, 0x1b, 0x21, 0x02, 0x8f, 0x86, 0x14, 0x92, 0xa3, 0xd4, 0x66,
+	0xd0, 0xef, 0x67, 0x00, 0x4e, 0x16, 0x91, 0xe9, 0xf7, 0xca, 0xb0, 0x09, 0x7c, 0x84, 0x36, 0xba,
+	0x84, 0xb1, 0xfc, 0x10, 0x6d, 0xa8, 0xa3, 0xd7, 0x52, 0x87, 0x31, 0x43, 0x1f, 0xa1, 0x5d, 0x0d,
+	0xf6, 0x38, 0x13, 0xc0, 0x44, 0x2c, 0x5c, 0xe2, 0xfb, 0x11, 0x08, 0x01, 0xc2, 0xc2, 0x2a, 0xca,
+	0x4a, 0x11, 0x67, 0x19, 0xe0, 0x24, 0xf3, 0xe3, 0x27, 0x68, 0xad, 0xcb, 0x99, 0x0f, 0xbe, 0x2b,
+	0xf9, 0x35, 0x30, 0x61, 0x6d, 0x36, 0x4a, 0xcd, 0xf2, 0xe9, 0xc1, 0xef, 0x6f, 0xf7, 0xb6, 0xd3,
+	0x77, 0x43, 0xf8, 0xd7, 0x36, 0xe5, 0xed, 0x80, 0xc8, 0xbe, 0x7d, 0xce, 0xe4, 0x2f, 0xaf, 0x5a,
+	0x28, 0x75, 0x24, 0x2b, 0x67, 0x35, 0x8d, 0x7e, 0xaa, 0x82, 0x31, 0xa0, 0x4d, 0x73, 0xdc, 0x32,
+	0xe9, 0x6c, 0xa9, 0xaa, 0xd8, 0x33, 0x4f, 0xb5, 0xa9, 0x1e, 0xcc, 0xef, 0x3a, 0x44, 0x72, 0xcb,
+	0x88, 0x01, 0x11, 0xfd, 0x7c, 0x81, 0xb6, 0xd3, 0x5b, 0x46, 0x7b, 0x8c, 0x0a, 0x7d, 0x81, 0x2a,
+	0x24, 0x96, 0x7d, 0x1e, 0x51, 0x49, 0x41, 0x58, 0x3b, 0x4a, 0x18, 0xcd, 0x7f, 0x99, 0xce, 0x31,
+	0xde, 0x31, 0x83, 0xf7, 0x2f, 0xd1, 0x7a, 0x5e, 0xe4, 0x49, 0xb3, 0x26, 0x97, 0x8d, 0x2e, 0xbc,
+	0xba, 0xf6, 0xcb, 0x4e, 0x6d, 0xec, 0xd0, 0x05, 0xc7, 0x5b, 0x68, 0x29, 0xbd, 0x4b, 0xe7, 0xd5,
+	0x28, 0xa7, 0x8b, 0xfd, 0x5f, 0xe7, 0x51, 0xed, 0xee, 0x44, 0x26, 0xf2, 0xe7, 0x21, 0x44, 0x53,
+	0x68, 0xab, 0x99, 0x3d, 0x63, 0xfd, 0x06, 0xd5, 0x26, 0xbd, 0x0f, 0xe3, 0xee, 0x35, 0x8c, 0x54,
+	0x82, 0xca, 0xf1, 0x96, 0x9d, 0x3e, 0xf7, 0x76, 0xf6, 0xdc, 0xdb, 0x27, 0x6c, 0x74, 0x6a, 0xfd,
+	0xfc, 0xaa, 0xb5, 0xa5, 0x9b, 0xe8, 0x45, 0xa3, 0x50, 0x72, 0xbb, 0x13, 0x77, 0xbf, 0x84, 0x91,
+	0x53, 0x1d, 0xf3, 0x74, 0x14, 0x0d, 0xee, 0xa0, 0x8a, 0x0f, 0xc2, 0x8b, 0x68, 0x98, 0xd4, 0xdf,
+	0x5a, 0x98, 0xa1, 0x76, 0x8f, 0x27, 0xf8, 0xec, 0x06, 0x30, 0x28, 0xb0, 0x83, 0x90, 0xc7, 0x83,
+	0x80, 0x0a, 0x91, 0x10, 0x2e, 0xce, 0x30, 0xa5, 0x67, 0x63, 0xb8, 0x43, 0x24, 0x64, 0x0f, 0xa5,
+	0xc1, 0x32, 0x29, 0xeb, 0x92, 0x59, 0xd6, 0x00, 0x6d, 0x4f, 0xbd, 0xca, 0xff, 0x5b, 0xcb, 0x0e,
+	0x50, 0x35, 0xa7, 0x69, 0xea, 0xab, 0xda, 0x96, 0x9d, 0x75, 0xd3, 0x7c, 0xee, 0xef, 0x3b, 0x68,
+	0xe3, 0x1f, 0x22, 0x9e, 0x16, 0x5d, 0x9a, 0x16, 0x9d, 0x57, 0xc6, 0x42, 0x76, 0x84, 0x0f, 0x10,
+	0x52, 0x3c, 0x67, 0xc9, 0x73, 0x3d, 0xc1, 0x94, 0x8c, 0x63, 0x7e, 0xb8, 0xf9, 0xc3, 0x9f, 0x3f,
+	0x3d, 0x58, 0x4f, 0xbe, 0x50, 0x26, 0xd0, 0xd3, 0x8f, 0x5f, 0xdf, 0xd4, 0x4b, 0x6f, 0x6e, 0xea,
+	0xa5, 0x3f, 0x6e, 0xea, 0xa5, 0x1f, 0x6f, 0xeb, 0x73, 0x6f, 0x6e, 0xeb, 0x73, 0xbf, 0xdd, 0xd6,
+	0xe7, 0xbe, 0xbd, 0xdf, 0xa3, 0xb2, 0x1f, 0x77, 0x6d, 0x8f, 0x07, 0x6d, 0xa3, 0xea, 0x2d, 0xf3,
+	0x5b, 0xa7, 0xbb, 0xac, 0xf4, 0xf2, 0xfe, 0xdf, 0x03, 0x00, 0x15, 0x90, 0x7d, 0xdf, 0xba, 0x0a,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -165,10 +663,35 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Vals) > 0 {
-		for iNdEx := len(m.Vals) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Authorities != nil {
+		{
+			size, err := m.Authorities.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.SlashedValidators) > 0 {
+		for iNdEx := len(m.SlashedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashedValidators[iNdEx])
+			copy(dAtA[i:], m.SlashedValidators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SlashedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.OrganizationPowers) > 0 {
+		for iNdEx := len(m.OrganizationPowers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Vals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.OrganizationPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -176,16 +699,400 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PowerCache) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if m.BondedTokens != nil {
+		{
+			size := m.BondedTokens.Size()
+			i -= size
+			if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.BannedConsensusAddresses) > 0 {
+		for iNdEx := len(m.BannedConsensusAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BannedConsensusAddresses[iNdEx])
+			copy(dAtA[i:], m.BannedConsensusAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BannedConsensusAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.BannedValidators) > 0 {
+		for iNdEx := len(m.BannedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BannedValidators[iNdEx])
+			copy(dAtA[i:], m.BannedValidators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BannedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.FeeRecipients) > 0 {
+		for iNdEx := len(m.FeeRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size, err := m.MaintenanceMode.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.AllowedPrefixes) > 0 {
+		for iNdEx := len(m.AllowedPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedPrefixes[iNdEx])
+			copy(dAtA[i:], m.AllowedPrefixes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.AllowedAccounts) > 0 {
+		for iNdEx := len(m.AllowedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedAccounts[iNdEx])
+			copy(dAtA[i:], m.AllowedAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AdminPowers) > 0 {
+		for iNdEx := len(m.AdminPowers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdminPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.AuditLogSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AuditLogSequence))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.AuditLog) > 0 {
+		for iNdEx := len(m.AuditLog) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuditLog[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ValidatorOrganizations) > 0 {
+		for iNdEx := len(m.ValidatorOrganizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorOrganizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Organizations) > 0 {
+		for iNdEx := len(m.Organizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Organizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.UpdatedValidators) > 0 {
+		for iNdEx := len(m.UpdatedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UpdatedValidators[iNdEx])
+			copy(dAtA[i:], m.UpdatedValidators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.UpdatedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.AbsoluteChangedInBlockPower != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AbsoluteChangedInBlockPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CachedBlockPower != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CachedBlockPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Vals) > 0 {
+		for iNdEx := len(m.Vals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ConsensusPubkey != nil {
+		{
+			size, err := m.ConsensusPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorOrganization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorOrganization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorOrganization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrganizationId) > 0 {
+		i -= len(m.OrganizationId)
+		copy(dAtA[i:], m.OrganizationId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OrganizationId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrganizationPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrganizationPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrganizationPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OrganizationId) > 0 {
+		i -= len(m.OrganizationId)
+		copy(dAtA[i:], m.OrganizationId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OrganizationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PowerCache) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -207,54 +1114,1376 @@ func (m *PowerCache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x8
 	}
-	return len(dAtA) - i, nil
-}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Vals) > 0 {
+		for _, e := range m.Vals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CachedBlockPower != 0 {
+		n += 1 + sovGenesis(uint64(m.CachedBlockPower))
+	}
+	if m.AbsoluteChangedInBlockPower != 0 {
+		n += 1 + sovGenesis(uint64(m.AbsoluteChangedInBlockPower))
+	}
+	if len(m.UpdatedValidators) > 0 {
+		for _, s := range m.UpdatedValidators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Organizations) > 0 {
+		for _, e := range m.Organizations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorOrganizations) > 0 {
+		for _, e := range m.ValidatorOrganizations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuditLog) > 0 {
+		for _, e := range m.AuditLog {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AuditLogSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.AuditLogSequence))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AdminPowers) > 0 {
+		for _, e := range m.AdminPowers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowedAccounts) > 0 {
+		for _, s := range m.AllowedAccounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowedPrefixes) > 0 {
+		for _, s := range m.AllowedPrefixes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.MaintenanceMode.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Paused {
+		n += 2
+	}
+	if len(m.FeeRecipients) > 0 {
+		for _, e := range m.FeeRecipients {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BannedValidators) > 0 {
+		for _, s := range m.BannedValidators {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BannedConsensusAddresses) > 0 {
+		for _, s := range m.BannedConsensusAddresses {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BondedTokens != nil {
+		l = m.BondedTokens.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.OrganizationPowers) > 0 {
+		for _, e := range m.OrganizationPowers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashedValidators) > 0 {
+		for _, s := range m.SlashedValidators {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Authorities != nil {
+		l = m.Authorities.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovGenesis(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *GenesisValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ConsensusPubkey != nil {
+		l = m.ConsensusPubkey.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Description.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovGenesis(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *ValidatorOrganization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.OrganizationId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *OrganizationPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrganizationId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovGenesis(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *PowerCache) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Power != 0 {
+		n += 1 + sovGenesis(uint64(m.Power))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vals = append(m.Vals, Validator{})
+			if err := m.Vals[len(m.Vals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CachedBlockPower", wireType)
+			}
+			m.CachedBlockPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CachedBlockPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbsoluteChangedInBlockPower", wireType)
+			}
+			m.AbsoluteChangedInBlockPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AbsoluteChangedInBlockPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedValidators = append(m.UpdatedValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Organizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Organizations = append(m.Organizations, Organization{})
+			if err := m.Organizations[len(m.Organizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorOrganizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorOrganizations = append(m.ValidatorOrganizations, ValidatorOrganization{})
+			if err := m.ValidatorOrganizations[len(m.ValidatorOrganizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditLog = append(m.AuditLog, AuditLogEntry{})
+			if err := m.AuditLog[len(m.AuditLog)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLogSequence", wireType)
+			}
+			m.AuditLogSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuditLogSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, GenesisValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminPowers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminPowers = append(m.AdminPowers, ValidatorPower{})
+			if err := m.AdminPowers[len(m.AdminPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedAccounts = append(m.AllowedAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPrefixes = append(m.AllowedPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipients = append(m.FeeRecipients, FeeRecipient{})
+			if err := m.FeeRecipients[len(m.FeeRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BannedValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BannedValidators = append(m.BannedValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BannedConsensusAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BannedConsensusAddresses = append(m.BannedConsensusAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.BondedTokens = &v
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrganizationPowers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrganizationPowers = append(m.OrganizationPowers, OrganizationPower{})
+			if err := m.OrganizationPowers[len(m.OrganizationPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedValidators = append(m.SlashedValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authorities == nil {
+				m.Authorities = &Authorities{}
+			}
+			if err := m.Authorities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusPubkey == nil {
+				m.ConsensusPubkey = &types.Any{}
+			}
+			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Vals) > 0 {
-		for _, e := range m.Vals {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+func (m *ValidatorOrganization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorOrganization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorOrganization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrganizationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrganizationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *PowerCache) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Power != 0 {
-		n += 1 + sovGenesis(uint64(m.Power))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *OrganizationPower) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -277,17 +2506,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrganizationPower: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrganizationPower: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrganizationId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -297,26 +2526,43 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vals = append(m.Vals, Validator{})
-			if err := m.Vals[len(m.Vals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OrganizationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,522 @@
+package poa_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+func newGenesisValidator(t *testing.T, pk cryptotypes.PubKey) poa.Validator {
+	t.Helper()
+
+	pkAny, err := codectypes.NewAnyWithValue(pk)
+	require.NoError(t, err)
+
+	return poa.Validator{
+		OperatorAddress: sdk.ValAddress(pk.Address()).String(),
+		ConsensusPubkey: pkAny,
+		Commission: poa.Commission{
+			CommissionRates: poa.NewCommissionRates(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(1, 2)),
+		},
+	}
+}
+
+func newGenesisActiveValidator(t *testing.T, pk cryptotypes.PubKey, power uint64) poa.GenesisValidator {
+	t.Helper()
+
+	pkAny, err := codectypes.NewAnyWithValue(pk)
+	require.NoError(t, err)
+
+	return poa.GenesisValidator{
+		OperatorAddress: sdk.ValAddress(pk.Address()).String(),
+		ConsensusPubkey: pkAny,
+		Description:     poa.NewDescription("genesis", "", "", "", ""),
+		Commission:      poa.NewCommissionRates(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(1, 2)),
+		Power:           power,
+	}
+}
+
+func TestGenesisValidate(t *testing.T) {
+	pk1 := ed25519.GenPrivKey().PubKey()
+	pk2 := ed25519.GenPrivKey().PubKey()
+	signer := sdk.AccAddress(pk1.Address()).String()
+
+	org := poa.NewOrganization("acme", "Acme Corp", poa.DefaultMaxOrganizationPowerShare)
+
+	testCases := []struct {
+		name         string
+		genesis      func() *poa.GenesisState
+		expectErrMsg string
+	}{
+		{
+			name:    "default",
+			genesis: poa.NewGenesisState,
+		},
+		{
+			name: "valid full state",
+			genesis: func() *poa.GenesisState {
+				val := newGenesisValidator(t, pk1)
+				return &poa.GenesisState{
+					Params:                      poa.DefaultParams(),
+					Vals:                        []poa.Validator{val, newGenesisValidator(t, pk2)},
+					CachedBlockPower:            10,
+					AbsoluteChangedInBlockPower: 2,
+					UpdatedValidators:           []string{val.OperatorAddress},
+					Organizations:               []poa.Organization{org},
+					ValidatorOrganizations:      []poa.ValidatorOrganization{{ValidatorAddress: val.OperatorAddress, OrganizationId: org.Id}},
+					AuditLog:                    []poa.AuditLogEntry{{Id: 0, Action: poa.AuditActionSetPower, Signer: signer, ValidatorAddress: val.OperatorAddress}},
+					AuditLogSequence:            1,
+				}
+			},
+		},
+		{
+			name: "invalid operator address",
+			genesis: func() *poa.GenesisState {
+				val := newGenesisValidator(t, pk1)
+				val.OperatorAddress = "cosmos1abc"
+				return &poa.GenesisState{Params: poa.DefaultParams(), Vals: []poa.Validator{val}}
+			},
+			expectErrMsg: "invalid validator address",
+		},
+		{
+			name: "duplicate operator",
+			genesis: func() *poa.GenesisState {
+				val := newGenesisValidator(t, pk1)
+				return &poa.GenesisState{Params: poa.DefaultParams(), Vals: []poa.Validator{val, val}}
+			},
+			expectErrMsg: "duplicate validator",
+		},
+		{
+			name: "duplicate consensus key",
+			genesis: func() *poa.GenesisState {
+				val1 := newGenesisValidator(t, pk1)
+				val2 := newGenesisValidator(t, pk1)
+				val2.OperatorAddress = sdk.ValAddress(pk2.Address()).String()
+				return &poa.GenesisState{Params: poa.DefaultParams(), Vals: []poa.Validator{val1, val2}}
+			},
+			expectErrMsg: "duplicate consensus pubkey",
+		},
+		{
+			name: "missing pubkey",
+			genesis: func() *poa.GenesisState {
+				val := newGenesisValidator(t, pk1)
+				val.ConsensusPubkey = nil
+				return &poa.GenesisState{Params: poa.DefaultParams(), Vals: []poa.Validator{val}}
+			},
+			expectErrMsg: "no consensus pubkey",
+		},
+		{
+			name: "pubkey not unpacked",
+			genesis: func() *poa.GenesisState {
+				val := newGenesisValidator(t, pk1)
+				val.ConsensusPubkey = &codectypes.Any{TypeUrl: "/cosmos.crypto.ed25519.PubKey", Value: []byte{0x1}}
+				return &poa.GenesisState{Params: poa.DefaultParams(), Vals: []poa.Validator{val}}
+			},
+			expectErrMsg: "could not be unpacked",
+		},
+		{
+			name: "commission above max rate",
+			genesis: func() *poa.GenesisState {
+				val := newGenesisValidator(t, pk1)
+				val.Commission.CommissionRates.Rate = math.LegacyNewDecWithPrec(3, 1)
+				return &poa.GenesisState{Params: poa.DefaultParams(), Vals: []poa.Validator{val}}
+			},
+			expectErrMsg: "invalid commission",
+		},
+		{
+			name: "valid genesis validators",
+			genesis: func() *poa.GenesisState {
+				return &poa.GenesisState{
+					Params:     poa.DefaultParams(),
+					Validators: []poa.GenesisValidator{newGenesisActiveValidator(t, pk1, 1_000_000), newGenesisActiveValidator(t, pk2, 5_000_000)},
+				}
+			},
+		},
+		{
+			name: "genesis validator power below minimum",
+			genesis: func() *poa.GenesisState {
+				return &poa.GenesisState{Params: poa.DefaultParams(), Validators: []poa.GenesisValidator{newGenesisActiveValidator(t, pk1, 999_999)}}
+			},
+			expectErrMsg: poa.ErrPowerBelowMinimum.Error(),
+		},
+		{
+			name: "genesis validator also pending",
+			genesis: func() *poa.GenesisState {
+				return &poa.GenesisState{
+					Params:     poa.DefaultParams(),
+					Vals:       []poa.Validator{newGenesisValidator(t, pk1)},
+					Validators: []poa.GenesisValidator{newGenesisActiveValidator(t, pk1, 1_000_000)},
+				}
+			},
+			expectErrMsg: "duplicate validator",
+		},
+		{
+			name: "genesis validator invalid commission",
+			genesis: func() *poa.GenesisState {
+				val := newGenesisActiveValidator(t, pk1, 1_000_000)
+				val.Commission.MaxRate = math.LegacyNewDecWithPrec(5, 2)
+				return &poa.GenesisState{Params: poa.DefaultParams(), Validators: []poa.GenesisValidator{val}}
+			},
+			expectErrMsg: "invalid commission",
+		},
+		{
+			name: "duplicate updated validator",
+			genesis: func() *poa.GenesisState {
+				valAddr := sdk.ValAddress(pk1.Address()).String()
+				return &poa.GenesisState{Params: poa.DefaultParams(), UpdatedValidators: []string{valAddr, valAddr}}
+			},
+			expectErrMsg: "duplicate updated validator",
+		},
+		{
+			name: "invalid organization",
+			genesis: func() *poa.GenesisState {
+				return &poa.GenesisState{Params: poa.DefaultParams(), Organizations: []poa.Organization{poa.NewOrganization("", "", math.LegacyOneDec())}}
+			},
+			expectErrMsg: "invalid organization",
+		},
+		{
+			name: "duplicate organization",
+			genesis: func() *poa.GenesisState {
+				return &poa.GenesisState{Params: poa.DefaultParams(), Organizations: []poa.Organization{org, org}}
+			},
+			expectErrMsg: "duplicate organization",
+		},
+		{
+			name: "validator assigned to unknown organization",
+			genesis: func() *poa.GenesisState {
+				return &poa.GenesisState{
+					Params:                 poa.DefaultParams(),
+					ValidatorOrganizations: []poa.ValidatorOrganization{{ValidatorAddress: sdk.ValAddress(pk1.Address()).String(), OrganizationId: org.Id}},
+				}
+			},
+			expectErrMsg: "unknown organization",
+		},
+		{
+			name: "power tracked for unknown organization",
+			genesis: func() *poa.GenesisState {
+				return &poa.GenesisState{
+					Params:             poa.DefaultParams(),
+					OrganizationPowers: []poa.OrganizationPower{{OrganizationId: org.Id, Power: 1}},
+				}
+			},
+			expectErrMsg: "power tracked for unknown organization",
+		},
+		{
+			name: "negative organization power",
+			genesis: func() *poa.GenesisState {
+				return &poa.GenesisState{
+					Params:             poa.DefaultParams(),
+					Organizations:      []poa.Organization{org},
+					OrganizationPowers: []poa.OrganizationPower{{OrganizationId: org.Id, Power: -1}},
+				}
+			},
+			expectErrMsg: "negative power",
+		},
+		{
+			name: "invalid admin power weight",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.Params.AdminPowerWeight = math.LegacyOneDec()
+				return gs
+			},
+			expectErrMsg: "admin power weight must be between 0 and 1",
+		},
+		{
+			name: "invalid blocked message type url",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.Params.BlockedMsgTypeUrls = []string{"cosmos.bank.v1beta1.MsgSend"}
+				return gs
+			},
+			expectErrMsg: "invalid blocked message type url",
+		},
+		{
+			name: "duplicate blocked message type url",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.Params.BlockedMsgTypeUrls = append(gs.Params.BlockedMsgTypeUrls, gs.Params.BlockedMsgTypeUrls[0])
+				return gs
+			},
+			expectErrMsg: "duplicate blocked message type url",
+		},
+		{
+			name: "blocked poa message",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.Params.BlockedMsgTypeUrls = []string{sdk.MsgTypeURL(&poa.MsgUpdateParams{})}
+				return gs
+			},
+			expectErrMsg: "can not be blocked",
+		},
+		{
+			name: "commission limit floor above ceil",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.Params.CommissionLimits.Rate = poa.NewDecRange(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(1, 1))
+				return gs
+			},
+			expectErrMsg: "invalid commission rate limit",
+		},
+		{
+			name: "commission limit above 1",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.Params.CommissionLimits.MaxRate = poa.NewDecRange(math.LegacyZeroDec(), math.LegacyNewDec(2))
+				return gs
+			},
+			expectErrMsg: "invalid commission max rate limit",
+		},
+		{
+			name: "admin powers in hybrid mode",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.Params.HybridMode = true
+				gs.Params.BlockedMsgTypeUrls = poa.HybridBlockedMsgTypeURLs()
+				gs.AdminPowers = []poa.ValidatorPower{{ValidatorAddress: sdk.ValAddress(pk1.Address()).String(), Power: 1_000_000}}
+				return gs
+			},
+		},
+		{
+			name: "hybrid mode blocking withdraw delegator rewards",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.Params.HybridMode = true
+				return gs
+			},
+			expectErrMsg: "can not be blocked in hybrid mode",
+		},
+		{
+			name: "admin powers without hybrid mode",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.AdminPowers = []poa.ValidatorPower{{ValidatorAddress: sdk.ValAddress(pk1.Address()).String(), Power: 1_000_000}}
+				return gs
+			},
+			expectErrMsg: "only allowed in hybrid mode",
+		},
+		{
+			name: "duplicate admin power",
+			genesis: func() *poa.GenesisState {
+				vp := poa.ValidatorPower{ValidatorAddress: sdk.ValAddress(pk1.Address()).String(), Power: 1_000_000}
+				gs := poa.NewGenesisState()
+				gs.Params.HybridMode = true
+				gs.Params.BlockedMsgTypeUrls = poa.HybridBlockedMsgTypeURLs()
+				gs.AdminPowers = []poa.ValidatorPower{vp, vp}
+				return gs
+			},
+			expectErrMsg: "duplicate admin power",
+		},
+		{
+			name: "audit log entry above sequence",
+			genesis: func() *poa.GenesisState {
+				return &poa.GenesisState{
+					Params:           poa.DefaultParams(),
+					AuditLog:         []poa.AuditLogEntry{{Id: 1, Action: poa.AuditActionSetPower, Signer: signer}},
+					AuditLogSequence: 1,
+				}
+			},
+			expectErrMsg: "not below the audit log sequence",
+		},
+		{
+			name: "duplicate audit log entry",
+			genesis: func() *poa.GenesisState {
+				entry := poa.AuditLogEntry{Id: 0, Action: poa.AuditActionSetPower, Signer: signer}
+				return &poa.GenesisState{
+					Params:           poa.DefaultParams(),
+					AuditLog:         []poa.AuditLogEntry{entry, entry},
+					AuditLogSequence: 1,
+				}
+			},
+			expectErrMsg: "duplicate audit log entry",
+		},
+		{
+			name: "allowed accounts and prefixes",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.AllowedAccounts = []string{signer}
+				gs.AllowedPrefixes = []string{"cosmos1", "cosmos1qqq"}
+				return gs
+			},
+		},
+		{
+			name: "invalid allowed account",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.AllowedAccounts = []string{"invalid"}
+				return gs
+			},
+			expectErrMsg: "invalid allowed account",
+		},
+		{
+			name: "duplicate allowed account",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.AllowedAccounts = []string{signer, signer}
+				return gs
+			},
+			expectErrMsg: "duplicate allowed account",
+		},
+		{
+			name: "invalid allowed prefix",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.AllowedPrefixes = []string{"cosmos1abc"}
+				return gs
+			},
+			expectErrMsg: "invalid bech32 character",
+		},
+		{
+			name: "maintenance mode",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.MaintenanceMode = poa.MaintenanceMode{Enabled: true, ExpiryHeight: 100, AllowedMsgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"}}
+				return gs
+			},
+		},
+		{
+			name: "disabled maintenance mode with an expiry height",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.MaintenanceMode = poa.MaintenanceMode{ExpiryHeight: 100}
+				return gs
+			},
+			expectErrMsg: "disabled maintenance mode",
+		},
+		{
+			name: "duplicate maintenance mode allowed message type url",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.MaintenanceMode = poa.MaintenanceMode{Enabled: true, AllowedMsgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}}
+				return gs
+			},
+			expectErrMsg: "duplicate allowed message type url",
+		},
+		{
+			name: "fee recipients",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.FeeRecipients = []poa.FeeRecipient{{
+					Address: signer,
+					Accrued: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(5, 1))),
+					Paid:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+				}}
+				return gs
+			},
+		},
+		{
+			name: "invalid fee recipient address",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.FeeRecipients = []poa.FeeRecipient{{Address: "invalid"}}
+				return gs
+			},
+			expectErrMsg: "invalid fee recipient address",
+		},
+		{
+			name: "duplicate fee recipient",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.FeeRecipients = []poa.FeeRecipient{{Address: signer}, {Address: signer}}
+				return gs
+			},
+			expectErrMsg: "duplicate fee recipient",
+		},
+		{
+			name: "banned validators",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.BannedValidators = []string{sdk.ValAddress(pk1.Address()).String()}
+				gs.BannedConsensusAddresses = []string{sdk.ConsAddress(pk1.Address()).String()}
+				return gs
+			},
+		},
+		{
+			name: "invalid banned consensus address",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.BannedConsensusAddresses = []string{sdk.ValAddress(pk1.Address()).String()}
+				return gs
+			},
+			expectErrMsg: "invalid banned consensus address",
+		},
+		{
+			name: "duplicate banned validator",
+			genesis: func() *poa.GenesisState {
+				valAddr := sdk.ValAddress(pk1.Address()).String()
+				gs := poa.NewGenesisState()
+				gs.BannedValidators = []string{valAddr, valAddr}
+				return gs
+			},
+			expectErrMsg: "duplicate banned validator",
+		},
+		{
+			name: "invalid slashed validator address",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.SlashedValidators = []string{sdk.ConsAddress(pk1.Address()).String()}
+				return gs
+			},
+			expectErrMsg: "invalid slashed validator address",
+		},
+		{
+			name: "duplicate slashed validator",
+			genesis: func() *poa.GenesisState {
+				valAddr := sdk.ValAddress(pk1.Address()).String()
+				gs := poa.NewGenesisState()
+				gs.SlashedValidators = []string{valAddr, valAddr}
+				return gs
+			},
+			expectErrMsg: "duplicate slashed validator",
+		},
+		{
+			name: "invalid recorded guardian",
+			genesis: func() *poa.GenesisState {
+				gs := poa.NewGenesisState()
+				gs.Authorities = &poa.Authorities{Authority: sdk.AccAddress(pk1.Address()).String(), Guardian: "guardian"}
+				return gs
+			},
+			expectErrMsg: "invalid recorded guardian",
+		},
+		{
+			name: "bonded tokens",
+			genesis: func() *poa.GenesisState {
+				bondedTokens := math.NewInt(1_000_000)
+				gs := poa.NewGenesisState()
+				gs.BondedTokens = &bondedTokens
+				return gs
+			},
+		},
+		{
+			name: "negative bonded tokens",
+			genesis: func() *poa.GenesisState {
+				bondedTokens := math.NewInt(-1)
+				gs := poa.NewGenesisState()
+				gs.BondedTokens = &bondedTokens
+				return gs
+			},
+			expectErrMsg: "bonded tokens can not be negative",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis().Validate()
+			if tc.expectErrMsg != "" {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.expectErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+// ValidateTxSigners returns an error if the account allowlist is enabled and a signer of the tx is not allowed. The
+// admin transactions are always allowed, so that the admin can not be locked out of the allowlist.
+func (k Keeper) ValidateTxSigners(ctx context.Context, msgs []sdk.Msg, signers [][]byte) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.AccountAllowlist.Enabled || k.IsAdminTx(ctx, msgs) {
+		return nil
+	}
+
+	for _, signer := range signers {
+		allowed, err := k.isSignerAllowed(ctx, signer, params.AccountAllowlist.AllowOperators)
+		if err != nil {
+			return err
+		}
+
+		if !allowed {
+			return errorsmod.Wrap(poa.ErrAccountNotAllowed, sdk.AccAddress(signer).String())
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) isSignerAllowed(ctx context.Context, signer []byte, allowOperators bool) (bool, error) {
+	account := sdk.AccAddress(signer).String()
+
+	allowed, err := k.IsAccountAllowed(ctx, account)
+	if err != nil || allowed {
+		return allowed, err
+	}
+
+	if !allowOperators {
+		return false, nil
+	}
+
+	if k.IsAdmin(ctx, account) || k.IsGuardian(account) {
+		return true, nil
+	}
+
+	// active, jailed or unbonding validators, and the validators pending admin approval.
+	valAddr := sdk.ValAddress(signer)
+	if _, err := k.stakingKeeper.GetValidator(ctx, valAddr); err == nil {
+		return true, nil
+	} else if !errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+		return false, err
+	}
+
+	return k.IsValidatorPending(ctx, valAddr.String())
+}
+
+// IsAccountAllowed checks if the account or one of its address prefixes is in the account allowlist.
+func (k Keeper) IsAccountAllowed(ctx context.Context, account string) (bool, error) {
+	allowed, err := k.AllowedAccounts.Has(ctx, account)
+	if err != nil || allowed {
+		return allowed, err
+	}
+
+	err = k.AllowedPrefixes.Walk(ctx, nil, func(prefix string) (bool, error) {
+		allowed = strings.HasPrefix(account, prefix)
+		return allowed, nil
+	})
+
+	return allowed, err
+}
+
+// UpdateAccountAllowlist removes then adds the accounts and address prefixes of the account allowlist.
+func (k Keeper) UpdateAccountAllowlist(ctx context.Context, msg *poa.MsgUpdateAccountAllowlist) error {
+	for _, account := range msg.RemoveAccounts {
+		if err := k.AllowedAccounts.Remove(ctx, account); err != nil {
+			return err
+		}
+	}
+
+	for _, prefix := range msg.RemovePrefixes {
+		if err := k.AllowedPrefixes.Remove(ctx, prefix); err != nil {
+			return err
+		}
+	}
+
+	for _, account := range msg.AddAccounts {
+		if err := k.AllowedAccounts.Set(ctx, account); err != nil {
+			return err
+		}
+	}
+
+	for _, prefix := range msg.AddPrefixes {
+		if err := k.AllowedPrefixes.Set(ctx, prefix); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetAllowedPrefixes returns the address prefixes of the account allowlist.
+func (k Keeper) GetAllowedPrefixes(ctx context.Context) ([]string, error) {
+	iter, err := k.AllowedPrefixes.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Keys()
+}
@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+func TestUpdateAccountAllowlist(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	admin := f.authorityAddr
+	alice := f.addrs[1].String()
+	bob := f.addrs[2].String()
+
+	testCases := []struct {
+		name     string
+		msg      *poa.MsgUpdateAccountAllowlist
+		accounts []string
+		prefixes []string
+		err      error
+	}{
+		{
+			name: "fail: not the admin",
+			msg:  &poa.MsgUpdateAccountAllowlist{Sender: alice, AddAccounts: []string{alice}},
+			err:  poa.ErrNotAnAuthority,
+		},
+		{
+			name: "fail: invalid account",
+			msg:  &poa.MsgUpdateAccountAllowlist{Sender: admin, AddAccounts: []string{"invalid"}},
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:     "add accounts and a prefix",
+			msg:      &poa.MsgUpdateAccountAllowlist{Sender: admin, AddAccounts: []string{alice, bob}, AddPrefixes: []string{"cosmos1qqq"}},
+			accounts: []string{alice, bob},
+			prefixes: []string{"cosmos1qqq"},
+		},
+		{
+			name:     "remove an account and the prefix",
+			msg:      &poa.MsgUpdateAccountAllowlist{Sender: admin, RemoveAccounts: []string{bob}, RemovePrefixes: []string{"cosmos1qqq"}},
+			accounts: []string{alice},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := f.msgServer.UpdateAccountAllowlist(f.ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(err, tc.err)
+				return
+			}
+			require.NoError(err)
+
+			res, err := f.queryServer.AccountAllowlist(f.ctx, &poa.QueryAccountAllowlistRequest{Pagination: &query.PageRequest{}})
+			require.NoError(err)
+			require.ElementsMatch(tc.accounts, res.Accounts)
+			require.ElementsMatch(tc.prefixes, res.Prefixes)
+		})
+	}
+}
+
+func TestValidateTxSigners(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 100)
+	require.NoError(err)
+	operator := sdk.AccAddress(MustValAddressFromBech32(vals[0].OperatorAddress))
+
+	admin := sdk.MustAccAddressFromBech32(f.authorityAddr)
+	alice := f.addrs[1]
+	bob := f.addrs[2]
+
+	_, err = f.msgServer.UpdateAccountAllowlist(f.ctx, &poa.MsgUpdateAccountAllowlist{
+		Sender:      f.authorityAddr,
+		AddAccounts: []string{alice.String()},
+	})
+	require.NoError(err)
+
+	send := []sdk.Msg{&banktypes.MsgSend{}}
+
+	testCases := []struct {
+		name           string
+		enabled        bool
+		allowOperators bool
+		msgs           []sdk.Msg
+		signers        []sdk.AccAddress
+		err            error
+	}{
+		{
+			name:    "allowlist disabled",
+			signers: []sdk.AccAddress{bob},
+		},
+		{
+			name:    "allowed account",
+			enabled: true,
+			signers: []sdk.AccAddress{alice},
+		},
+		{
+			name:    "fail: account not allowed",
+			enabled: true,
+			signers: []sdk.AccAddress{alice, bob},
+			err:     poa.ErrAccountNotAllowed,
+		},
+		{
+			name:    "admin tx",
+			enabled: true,
+			msgs:    []sdk.Msg{&poa.MsgUpdateAccountAllowlist{Sender: f.authorityAddr}},
+			signers: []sdk.AccAddress{admin},
+		},
+		{
+			name:           "validator operator allowed",
+			enabled:        true,
+			allowOperators: true,
+			signers:        []sdk.AccAddress{operator},
+		},
+		{
+			name:           "authority allowed",
+			enabled:        true,
+			allowOperators: true,
+			signers:        []sdk.AccAddress{admin},
+		},
+		{
+			name:    "fail: validator operator not allowed",
+			enabled: true,
+			signers: []sdk.AccAddress{operator},
+			err:     poa.ErrAccountNotAllowed,
+		},
+		{
+			name:    "fail: authority not allowed",
+			enabled: true,
+			signers: []sdk.AccAddress{admin},
+			err:     poa.ErrAccountNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := poa.DefaultParams()
+			params.AccountAllowlist = poa.AccountAllowlistParams{Enabled: tc.enabled, AllowOperators: tc.allowOperators}
+			require.NoError(f.k.SetParams(f.ctx, params))
+
+			msgs := tc.msgs
+			if msgs == nil {
+				msgs = send
+			}
+
+			signers := make([][]byte, len(tc.signers))
+			for i, signer := range tc.signers {
+				signers[i] = signer
+			}
+
+			err := f.k.ValidateTxSigners(f.ctx, msgs, signers)
+			if tc.err != nil {
+				require.ErrorIs(err, tc.err)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+}
+
+func TestIsAccountAllowedPrefix(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	alice := f.addrs[1].String()
+
+	allowed, err := f.k.IsAccountAllowed(f.ctx, alice)
+	require.NoError(err)
+	require.False(allowed)
+
+	_, err = f.msgServer.UpdateAccountAllowlist(f.ctx, &poa.MsgUpdateAccountAllowlist{
+		Sender:      f.authorityAddr,
+		AddPrefixes: []string{alice[:len(alice)-4]},
+	})
+	require.NoError(err)
+
+	allowed, err = f.k.IsAccountAllowed(f.ctx, alice)
+	require.NoError(err)
+	require.True(allowed)
+}
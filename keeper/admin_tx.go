@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+// IsAdminTx checks if the messages of a tx are only POA messages sent by the admin. Messages nested in other messages
+// are not considered, their signer is the one of the wrapping message.
+func (k Keeper) IsAdminTx(ctx context.Context, msgs []sdk.Msg) bool {
+	if len(msgs) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		sender, ok := poa.AdminMsgSender(msg)
+		if !ok || !k.IsAdmin(ctx, sender) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+func TestIsAdminTx(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+
+	admin := f.authorityAddr
+	other := f.addrs[1].String()
+
+	testCases := []struct {
+		name    string
+		msgs    []sdk.Msg
+		isAdmin bool
+	}{
+		{
+			name:    "admin messages",
+			msgs:    []sdk.Msg{&poa.MsgSetPower{Sender: admin}, &poa.MsgRemoveValidator{Sender: admin}},
+			isAdmin: true,
+		},
+		{
+			name: "no messages",
+		},
+		{
+			name: "message from another sender",
+			msgs: []sdk.Msg{&poa.MsgSetPower{Sender: admin}, &poa.MsgRemoveValidator{Sender: other}},
+		},
+		{
+			name: "non POA message from the admin",
+			msgs: []sdk.Msg{&poa.MsgSetPower{Sender: admin}, &banktypes.MsgSend{FromAddress: admin}},
+		},
+		{
+			name: "POA message without a sender",
+			msgs: []sdk.Msg{&poa.MsgCreateValidator{ValidatorAddress: admin}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.isAdmin, f.k.IsAdminTx(f.ctx, tc.msgs))
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"cosmossdk.io/collections"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+// AuditAuthorityChange records a change of the admin or the guardian in the audit log. Both are set by the app
+// configuration, so a change made by an upgrade or a restart is recorded in the next begin block, signed by the new
+// admin.
+func (k Keeper) AuditAuthorityChange(ctx context.Context) error {
+	current := poa.Authorities{Authority: k.authority, Guardian: k.guardian}
+
+	prev, err := k.Authorities.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		// the authorities are recorded for the first time, there is no change to audit.
+		return k.Authorities.Set(ctx, current)
+	} else if err != nil {
+		return err
+	}
+
+	if prev.Authority == current.Authority && prev.Guardian == current.Guardian {
+		return nil
+	}
+
+	change := &poa.AuthorityChange{Previous: prev, Current: current}
+	if err := k.AppendAuditLog(ctx, poa.AuditActionUpdateAuthority, current.Authority, "", change); err != nil {
+		return err
+	}
+
+	return k.Authorities.Set(ctx, current)
+}
+
+// AppendAuditLog records an administrative action in the append-only audit log.
+func (k Keeper) AppendAuditLog(ctx context.Context, action, signer, valOpAddr string, msg sdk.Msg) error {
+	id, err := k.AuditLogSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var txHash string
+	if txBytes := sdkCtx.TxBytes(); len(txBytes) > 0 {
+		txHash = fmt.Sprintf("%X", tmhash.Sum(txBytes))
+	}
+
+	return k.AuditLog.Set(ctx, id, poa.AuditLogEntry{
+		Id:               id,
+		Action:           action,
+		Signer:           signer,
+		ValidatorAddress: valOpAddr,
+		Height:           sdkCtx.BlockHeight(),
+		Time:             sdkCtx.BlockTime(),
+		TxHash:           txHash,
+		Msg:              msgAny,
+	})
+}
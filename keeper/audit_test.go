@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+func TestAuditLog(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 100)
+	require.NoError(err)
+
+	// the base validators are set up with the unsafe SetPower message.
+	r, err := f.queryServer.AuditLog(f.ctx, &poa.QueryAuditLogRequest{})
+	require.NoError(err)
+	baseEntries := len(r.Entries)
+	require.Equal(len(vals), baseEntries)
+
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+		Power:            3_000_000,
+	})
+	require.NoError(err)
+
+	// failed actions are not recorded.
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.addrs[1].String(),
+		ValidatorAddress: vals[0].OperatorAddress,
+		Power:            4_000_000,
+	})
+	require.Error(err)
+
+	pending := f.CreatePendingValidator("pending", 1_000_000)
+	_, err = f.msgServer.RemovePending(f.ctx, &poa.MsgRemovePending{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: pending.String(),
+	})
+	require.NoError(err)
+
+	_, err = f.msgServer.UpdateStakingParams(f.ctx, &poa.MsgUpdateStakingParams{
+		Sender: f.authorityAddr,
+		Params: poa.DefaultStakingParams(),
+	})
+	require.NoError(err)
+
+	r, err = f.queryServer.AuditLog(f.ctx, &poa.QueryAuditLogRequest{})
+	require.NoError(err)
+	require.Len(r.Entries, baseEntries+3)
+
+	last := r.Entries[len(r.Entries)-1]
+	require.EqualValues(baseEntries+2, last.Id)
+	require.Equal(poa.AuditActionUpdateStakingParams, last.Action)
+	require.Equal(f.authorityAddr, last.Signer)
+	require.Equal(f.ctx.BlockHeight(), last.Height)
+	require.Equal(f.ctx.BlockTime().UTC(), last.Time.UTC())
+	require.Equal("/strangelove_ventures.poa.v1.MsgUpdateStakingParams", last.Msg.TypeUrl)
+
+	// filter by action
+	r, err = f.queryServer.AuditLog(f.ctx, &poa.QueryAuditLogRequest{Action: poa.AuditActionRemovePending})
+	require.NoError(err)
+	require.Len(r.Entries, 1)
+	require.Equal(pending.String(), r.Entries[0].ValidatorAddress)
+
+	// filter by validator
+	r, err = f.queryServer.AuditLog(f.ctx, &poa.QueryAuditLogRequest{ValidatorAddress: vals[0].OperatorAddress})
+	require.NoError(err)
+	require.Len(r.Entries, 2)
+
+	// filter by action and validator
+	r, err = f.queryServer.AuditLog(f.ctx, &poa.QueryAuditLogRequest{
+		Action:           poa.AuditActionSetPower,
+		ValidatorAddress: vals[1].OperatorAddress,
+	})
+	require.NoError(err)
+	require.Len(r.Entries, 1)
+
+	// pagination
+	r, err = f.queryServer.AuditLog(f.ctx, &poa.QueryAuditLogRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(err)
+	require.Len(r.Entries, 2)
+	require.EqualValues(baseEntries+3, r.Pagination.Total)
+	require.NotEmpty(r.Pagination.NextKey)
+}
+
+func TestAuditAuthorityChange(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	updateAuthorityEntries := func() []poa.AuditLogEntry {
+		r, err := f.queryServer.AuditLog(f.ctx, &poa.QueryAuditLogRequest{Action: poa.AuditActionUpdateAuthority})
+		require.NoError(err)
+		return r.Entries
+	}
+
+	// the authorities recorded for the first time are not a change.
+	require.NoError(f.k.Authorities.Remove(f.ctx))
+	require.NoError(f.k.AuditAuthorityChange(f.ctx))
+	require.Empty(updateAuthorityEntries())
+
+	recorded, err := f.k.Authorities.Get(f.ctx)
+	require.NoError(err)
+	require.Equal(poa.Authorities{Authority: f.authorityAddr}, recorded)
+
+	// a guardian set by the app configuration is recorded once.
+	guardian := f.addrs[1].String()
+	f.k.SetGuardian(guardian)
+
+	require.NoError(f.k.AuditAuthorityChange(f.ctx))
+	require.NoError(f.k.AuditAuthorityChange(f.ctx))
+
+	entries := updateAuthorityEntries()
+	require.Len(entries, 1)
+	require.Equal(f.authorityAddr, entries[0].Signer)
+	require.Equal(f.ctx.BlockHeight(), entries[0].Height)
+
+	var change poa.AuthorityChange
+	require.NoError(change.Unmarshal(entries[0].Msg.Value))
+	require.Equal(poa.AuthorityChange{
+		Previous: poa.Authorities{Authority: f.authorityAddr},
+		Current:  poa.Authorities{Authority: f.authorityAddr, Guardian: guardian},
+	}, change)
+
+	// an admin replaced by an upgrade is recorded with the new admin as signer.
+	previous := poa.Authorities{Authority: f.addrs[2].String(), Guardian: guardian}
+	require.NoError(f.k.Authorities.Set(f.ctx, previous))
+	require.NoError(f.k.AuditAuthorityChange(f.ctx))
+
+	entries = updateAuthorityEntries()
+	require.Len(entries, 2)
+	change = poa.AuthorityChange{}
+	require.NoError(change.Unmarshal(entries[1].Msg.Value))
+	require.Equal(previous, change.Previous)
+	require.Equal(f.authorityAddr, change.Current.Authority)
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+)
+
+// The bonded tokens are the sum of the delegations to the POA validators, which the bonded pool must hold. They are
+// tracked from the change of the self delegation every time the power of a validator is set, so a power update does
+// not iterate the whole validator set. The tracked total is reconciled with the delegations when it is first read,
+// periodically in the begin blocker and by the bonded tokens invariant. Hybrid mode leaves the delegations to x/staking,
+// the total is reconciled again once the mode is toggled.
+
+// BondedTokensReconcileInterval is the number of blocks between the reconciliations of the tracked bonded tokens.
+const BondedTokensReconcileInterval = 1000
+
+// GetBondedTokens returns the tracked bonded tokens, reconciled from the delegations if they are not tracked yet.
+func (k Keeper) GetBondedTokens(ctx context.Context) (sdkmath.Int, error) {
+	total, err := k.BondedTokens.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return k.ReconcileBondedTokens(ctx)
+	}
+
+	return total, err
+}
+
+// ReconcileBondedTokens sets the tracked bonded tokens to the sum of all delegations and returns it.
+func (k Keeper) ReconcileBondedTokens(ctx context.Context) (sdkmath.Int, error) {
+	total, err := k.sumDelegations(ctx)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	if tracked, err := k.BondedTokens.Get(ctx); err == nil && !tracked.Equal(total) {
+		k.Logger().Error("tracked bonded tokens drifted from the delegations", "tracked", tracked, "delegations", total)
+	} else if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return sdkmath.Int{}, err
+	}
+
+	return total, k.BondedTokens.Set(ctx, total)
+}
+
+// ReconcileBondedTokensPeriodically reconciles the tracked bonded tokens every BondedTokensReconcileInterval blocks.
+func (k Keeper) ReconcileBondedTokensPeriodically(ctx context.Context) error {
+	if sdk.UnwrapSDKContext(ctx).BlockHeight()%BondedTokensReconcileInterval != 0 {
+		return nil
+	}
+
+	if hybrid, err := k.IsHybridMode(ctx); err != nil || hybrid {
+		return err
+	}
+
+	_, err := k.ReconcileBondedTokens(ctx)
+	return err
+}
+
+// sumDelegations returns the sum of the shares of all delegations.
+func (k Keeper) sumDelegations(ctx context.Context) (sdkmath.Int, error) {
+	dels, err := k.stakingKeeper.GetAllDelegations(ctx)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	total := sdkmath.ZeroInt()
+	for _, d := range dels {
+		total = total.Add(d.Shares.RoundInt())
+	}
+
+	return total, nil
+}
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/strangelove-ventures/poa"
+	"github.com/strangelove-ventures/poa/keeper"
+)
+
+func TestBondedTokens(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 100)
+	require.NoError(err)
+
+	bondedPool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName)
+
+	tracked, err := f.k.BondedTokens.Get(f.ctx)
+	require.NoError(err)
+	require.EqualValues(6_000_000, tracked.Int64())
+
+	// the power update is tracked from the change of the self delegation.
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+		Power:            5_000_000,
+		Unsafe:           true,
+	})
+	require.NoError(err)
+
+	tracked, err = f.k.BondedTokens.Get(f.ctx)
+	require.NoError(err)
+	require.EqualValues(9_000_000, tracked.Int64())
+	require.EqualValues(9_000_000, f.bankkeeper.GetBalance(f.ctx, bondedPool, "stake").Amount.Int64())
+
+	totalPower, err := f.stakingKeeper.GetLastTotalPower(f.ctx)
+	require.NoError(err)
+	require.EqualValues(9, totalPower.Int64())
+
+	_, broken := keeper.BondedTokensInvariant(f.k)(f.ctx)
+	require.False(broken)
+
+	// the excess of a lower power is burned from the bonded pool.
+	supply := f.bankkeeper.GetSupply(f.ctx, "stake").Amount
+
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+		Power:            3_000_000,
+		Unsafe:           true,
+	})
+	require.NoError(err)
+
+	require.EqualValues(7_000_000, f.bankkeeper.GetBalance(f.ctx, bondedPool, "stake").Amount.Int64())
+	require.EqualValues(2_000_000, supply.Sub(f.bankkeeper.GetSupply(f.ctx, "stake").Amount).Int64())
+
+	// a removed validator leaves the bonded tokens.
+	_, err = f.IncreaseBlock(1)
+	require.NoError(err)
+
+	_, err = f.msgServer.RemoveValidator(f.ctx, &poa.MsgRemoveValidator{Sender: f.authorityAddr, ValidatorAddress: vals[1].OperatorAddress})
+	require.NoError(err)
+
+	tracked, err = f.k.BondedTokens.Get(f.ctx)
+	require.NoError(err)
+	require.EqualValues(5_000_000, tracked.Int64())
+	require.EqualValues(5_000_000, f.bankkeeper.GetBalance(f.ctx, bondedPool, "stake").Amount.Int64())
+
+	_, broken = keeper.BondedTokensInvariant(f.k)(f.ctx)
+	require.False(broken)
+
+	// a drift breaks the invariant until the tracked total is reconciled.
+	require.NoError(f.k.BondedTokens.Set(f.ctx, sdkmath.NewInt(1)))
+
+	_, broken = keeper.BondedTokensInvariant(f.k)(f.ctx)
+	require.True(broken)
+
+	f.ctx = f.ctx.WithBlockHeight(keeper.BondedTokensReconcileInterval)
+	require.NoError(f.k.ReconcileBondedTokensPeriodically(f.ctx))
+
+	tracked, err = f.k.BondedTokens.Get(f.ctx)
+	require.NoError(err)
+	require.EqualValues(5_000_000, tracked.Int64())
+
+	// the total is reconciled when it is read again after it was untracked.
+	require.NoError(f.k.BondedTokens.Remove(f.ctx))
+
+	_, broken = keeper.BondedTokensInvariant(f.k)(f.ctx)
+	require.False(broken)
+
+	tracked, err = f.k.GetBondedTokens(f.ctx)
+	require.NoError(err)
+	require.EqualValues(5_000_000, tracked.Int64())
+}
+
+// setupBenchmarkValidators adds n validators with 1 power each to the base set. Each validator is added in constant
+// time, so the fixture is built in O(n).
+func setupBenchmarkValidators(tb testing.TB, n int) (*testFixture, []string) {
+	tb.Helper()
+
+	f := SetupTest(tb, 2_000_000)
+
+	vals := make([]string, n)
+	for i := range vals {
+		val := GenAcc()
+		valAddr := sdk.ValAddress(val.addr).String()
+
+		v := poa.ConvertPOAToStaking(CreateNewValidator(fmt.Sprintf("bench-%d", i), valAddr, val.valKey.PubKey(), 1_000_000))
+		require.NoError(tb, f.k.AddPendingValidator(f.ctx, v, val.valKey.PubKey()))
+
+		_, err := f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+			Sender:           f.authorityAddr,
+			ValidatorAddress: valAddr,
+			Power:            1_000_000,
+			Unsafe:           true,
+		})
+		require.NoError(tb, err)
+
+		vals[i] = valAddr
+	}
+
+	return f, vals
+}
+
+// gasUsed returns the store gas consumed by fn.
+func (f *testFixture) gasUsed(tb testing.TB, fn func(ctx sdk.Context) error) uint64 {
+	tb.Helper()
+
+	ctx := f.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	require.NoError(tb, fn(ctx))
+
+	return ctx.GasMeter().GasConsumed()
+}
+
+// TestPowerUpdateFlatCost checks a power update reads and writes the same amount of state whatever the size of the
+// validator set, with and without organizations.
+func TestPowerUpdateFlatCost(t *testing.T) {
+	sizes := []int{100, 2_000}
+
+	for _, withOrg := range []bool{false, true} {
+		var setPowerGas, poolGas []uint64
+		for _, n := range sizes {
+			f, vals := setupBenchmarkValidators(t, n)
+
+			if withOrg {
+				require.NoError(t, f.k.SetOrganization(f.ctx, poa.NewOrganization("acme", "", sdkmath.LegacyOneDec())))
+				require.NoError(t, f.k.SetValidatorOrganization(f.ctx, vals[0], "acme"))
+			}
+
+			setPowerGas = append(setPowerGas, f.gasUsed(t, func(ctx sdk.Context) error {
+				_, err := f.k.SetPOAPower(ctx, vals[n/2], 2_000_000)
+				return err
+			}))
+
+			poolGas = append(poolGas, f.gasUsed(t, func(ctx sdk.Context) error {
+				return f.k.UpdateBondedPoolPower(ctx)
+			}))
+		}
+
+		// the gas only grows with the length of the keys and values, e.g. the validator count in a description.
+		require.InEpsilon(t, setPowerGas[0], setPowerGas[1], 0.01, "SetPOAPower gas %v for %v validators", setPowerGas, sizes)
+		require.InEpsilon(t, poolGas[0], poolGas[1], 0.01, "UpdateBondedPoolPower gas %v for %v validators", poolGas, sizes)
+	}
+}
+
+func BenchmarkSetPOAPower(b *testing.B) {
+	for _, n := range []int{100, 1_000, 5_000} {
+		b.Run(fmt.Sprintf("validators=%d", n), func(b *testing.B) {
+			f, vals := setupBenchmarkValidators(b, n)
+			ctx := f.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				// alternate the power, setting the same power again is rejected.
+				shares := uint64(2_000_000 + (i/len(vals)%2)*1_000_000)
+				if _, err := f.k.SetPOAPower(ctx, vals[i%len(vals)], shares); err != nil {
+					b.Fatal(err)
+				}
+			}
+
+			b.ReportMetric(float64(ctx.GasMeter().GasConsumed())/float64(b.N), "gas/op")
+		})
+	}
+}
+
+func BenchmarkUpdateBondedPoolPower(b *testing.B) {
+	for _, n := range []int{100, 1_000, 5_000} {
+		b.Run(fmt.Sprintf("validators=%d", n), func(b *testing.B) {
+			f, _ := setupBenchmarkValidators(b, n)
+			ctx := f.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := f.k.UpdateBondedPoolPower(ctx); err != nil {
+					b.Fatal(err)
+				}
+			}
+
+			b.ReportMetric(float64(ctx.GasMeter().GasConsumed())/float64(b.N), "gas/op")
+		})
+	}
+}
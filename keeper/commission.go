@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+// ValidateCommissionMsg returns an error if the commission set by a create or edit validator message is outside of
+// the commission limits of the params. An edit that does not change the commission rate is always valid.
+func (k Keeper) ValidateCommissionMsg(ctx context.Context, msg sdk.Msg) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	limits := params.CommissionLimits
+
+	switch msg := msg.(type) {
+	case *poa.MsgCreateValidator:
+		return limits.CheckRates(msg.Commission.Rate, msg.Commission.MaxRate, msg.Commission.MaxChangeRate)
+	case *stakingtypes.MsgCreateValidator:
+		return limits.CheckRates(msg.Commission.Rate, msg.Commission.MaxRate, msg.Commission.MaxChangeRate)
+	case *stakingtypes.MsgEditValidator:
+		if msg.CommissionRate == nil {
+			return nil
+		}
+
+		return limits.CheckRate(*msg.CommissionRate)
+	default:
+		return nil
+	}
+}
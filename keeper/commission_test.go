@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+func TestValidateCommissionMsg(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	params := poa.DefaultParams()
+	params.CommissionLimits.Rate = poa.NewDecRange(sdkmath.LegacyNewDecWithPrec(1, 1), sdkmath.LegacyNewDecWithPrec(5, 1))
+	params.CommissionLimits.MaxRate = poa.NewDecRange(sdkmath.LegacyNewDecWithPrec(2, 1), sdkmath.LegacyNewDecWithPrec(8, 1))
+	params.CommissionLimits.MaxChangeRate = poa.NewDecRange(sdkmath.LegacyZeroDec(), sdkmath.LegacyNewDecWithPrec(5, 2))
+	_, err := f.msgServer.UpdateParams(f.ctx, &poa.MsgUpdateParams{Sender: f.authorityAddr, Params: params})
+	require.NoError(err)
+
+	commission := func(rate, maxRate, maxChangeRate string) poa.CommissionRates {
+		return poa.NewCommissionRates(
+			sdkmath.LegacyMustNewDecFromStr(rate),
+			sdkmath.LegacyMustNewDecFromStr(maxRate),
+			sdkmath.LegacyMustNewDecFromStr(maxChangeRate),
+		)
+	}
+	rate := sdkmath.LegacyMustNewDecFromStr("0.6")
+
+	testCases := []struct {
+		name string
+		msg  sdk.Msg
+		err  error
+	}{
+		{
+			name: "create validator within the limits",
+			msg:  &poa.MsgCreateValidator{Commission: commission("0.1", "0.2", "0.01")},
+		},
+		{
+			name: "fail: rate below the limit",
+			msg:  &poa.MsgCreateValidator{Commission: commission("0.05", "0.2", "0.01")},
+			err:  poa.ErrCommissionOutOfRange,
+		},
+		{
+			name: "fail: max rate above the limit",
+			msg:  &poa.MsgCreateValidator{Commission: commission("0.1", "0.9", "0.01")},
+			err:  poa.ErrCommissionOutOfRange,
+		},
+		{
+			name: "fail: staking create validator max change rate above the limit",
+			msg: &stakingtypes.MsgCreateValidator{Commission: stakingtypes.NewCommissionRates(
+				sdkmath.LegacyMustNewDecFromStr("0.1"),
+				sdkmath.LegacyMustNewDecFromStr("0.2"),
+				sdkmath.LegacyMustNewDecFromStr("0.1"),
+			)},
+			err: poa.ErrCommissionOutOfRange,
+		},
+		{
+			name: "edit validator without a commission change",
+			msg:  &stakingtypes.MsgEditValidator{},
+		},
+		{
+			name: "fail: edit validator rate above the limit",
+			msg:  &stakingtypes.MsgEditValidator{CommissionRate: &rate},
+			err:  poa.ErrCommissionOutOfRange,
+		},
+		{
+			name: "other message",
+			msg:  &stakingtypes.MsgDelegate{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := f.k.ValidateCommissionMsg(f.ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(err, tc.err)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+
+	// the message server enforces the limits for the messages not going through the ante handler.
+	val := GenAcc()
+	msg, err := poa.NewMsgCreateValidator(
+		sdk.ValAddress(val.addr).String(),
+		val.valKey.PubKey(),
+		poa.NewDescription("val", "", "", "", ""),
+		commission("0.1", "0.9", "0.01"),
+		sdkmath.OneInt(),
+	)
+	require.NoError(err)
+
+	_, err = f.msgServer.CreateValidator(f.ctx, msg)
+	require.ErrorIs(err, poa.ErrCommissionOutOfRange)
+}
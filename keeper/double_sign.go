@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+// A validator tombstoned by x/evidence for double signing is only jailed by x/slashing. POA removes it from the set
+// with the POA removal path, and bans its operator address and consensus key so it can not be created or accepted
+// again, by the same or a new operator.
+
+// HandleTombstonedValidators removes and bans the validators slashed since the last check which x/slashing
+// tombstoned. It runs in the begin blocker after x/evidence, a validator tombstoned by a later module is handled in the
+// next block.
+func (k Keeper) HandleTombstonedValidators(ctx context.Context) error {
+	var slashed []sdk.ValAddress
+	if err := k.SlashedValidators.Walk(ctx, nil, func(valAddr sdk.ValAddress) (bool, error) {
+		slashed = append(slashed, valAddr)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	// the removal slashes the validator again, it is checked again in the next block.
+	if err := k.SlashedValidators.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	if k.slashKeeper == nil {
+		return nil
+	}
+
+	for _, valAddr := range slashed {
+		val, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return err
+		}
+
+		info, err := k.slashKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		if errors.Is(err, slashingtypes.ErrNoSigningInfoFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if !info.Tombstoned {
+			continue
+		}
+
+		if banned, err := k.BannedValidators.Has(ctx, val.OperatorAddress); err != nil {
+			return err
+		} else if banned {
+			continue
+		}
+
+		if err := k.removeTombstonedValidator(ctx, val, sdk.ConsAddress(consAddr)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeTombstonedValidator bans a tombstoned validator and removes it from the set and the pending validators. Its
+// signing info is kept, clearing it would reset the tombstone.
+func (k Keeper) removeTombstonedValidator(ctx context.Context, val stakingtypes.Validator, consAddr sdk.ConsAddress) error {
+	if err := k.BannedValidators.Set(ctx, val.OperatorAddress); err != nil {
+		return err
+	}
+
+	if err := k.BannedConsensusAddresses.Set(ctx, consAddr.String()); err != nil {
+		return err
+	}
+
+	valAddr, err := k.GetValidatorAddressCodec().StringToBytes(val.OperatorAddress)
+	if err != nil {
+		return err
+	}
+
+	oldPower, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	// x/staking already removed a validator tombstoned in an earlier block from the set.
+	if oldPower > 0 {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		cacheCtx, write := sdkCtx.CacheContext()
+		if _, err := k.SetPOAPower(cacheCtx, val.OperatorAddress, 0); err != nil {
+			k.Logger().Error("failed to remove the tombstoned validator", "validator", val.OperatorAddress, "error", err)
+		} else {
+			write()
+		}
+	}
+
+	if err := k.RemovePendingValidator(ctx, val.OperatorAddress); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&poa.EventValidatorTombstoned{
+		ValidatorAddress: val.OperatorAddress,
+		ConsensusAddress: consAddr.String(),
+		OldPower:         oldPower,
+	})
+}
+
+// checkNotBanned returns ErrValidatorBanned if the operator address or the consensus address of a validator is banned.
+// An empty address is not checked.
+func (k Keeper) checkNotBanned(ctx context.Context, operatorAddr string, consAddr sdk.ConsAddress) error {
+	if operatorAddr != "" {
+		if banned, err := k.BannedValidators.Has(ctx, operatorAddr); err != nil {
+			return err
+		} else if banned {
+			return errorsmod.Wrapf(poa.ErrValidatorBanned, "operator address %s", operatorAddr)
+		}
+	}
+
+	if consAddr != nil {
+		if banned, err := k.BannedConsensusAddresses.Has(ctx, consAddr.String()); err != nil {
+			return err
+		} else if banned {
+			return errorsmod.Wrapf(poa.ErrValidatorBanned, "consensus address %s", consAddr)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+// doubleSign slashes, jails and tombstones a validator as x/evidence does for a double sign, with a 0 slash fraction.
+func (f *testFixture) doubleSign(t *testing.T, val stakingtypes.Validator, tombstone bool) sdk.ConsAddress {
+	t.Helper()
+
+	consAddr, err := val.GetConsAddr()
+	require.NoError(t, err)
+
+	require.NoError(t, f.slashingKeeper.SetValidatorSigningInfo(f.ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, f.ctx.BlockTime(), false, 0)))
+
+	power := f.stakingKeeper.TokensToConsensusPower(f.ctx, val.Tokens)
+	_, err = f.stakingKeeper.Slash(f.ctx, consAddr, f.ctx.BlockHeight(), power, sdkmath.LegacyZeroDec())
+	require.NoError(t, err)
+
+	if tombstone {
+		require.NoError(t, f.stakingKeeper.Jail(f.ctx, consAddr))
+		require.NoError(t, f.slashingKeeper.Tombstone(f.ctx, consAddr))
+	}
+
+	return consAddr
+}
+
+func TestDoubleSign(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 100)
+	require.NoError(err)
+
+	consAddr := f.doubleSign(t, vals[0], true)
+
+	// a validator slashed without a tombstone is kept.
+	f.doubleSign(t, vals[1], false)
+
+	f.ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(f.k.HandleTombstonedValidators(f.ctx))
+
+	event := requireTypedEvent[*poa.EventValidatorTombstoned](t, f.ctx)
+	require.Equal(vals[0].OperatorAddress, event.ValidatorAddress)
+	require.Equal(consAddr.String(), event.ConsensusAddress)
+	require.EqualValues(2, event.OldPower)
+
+	powers := f.validatorPowers(t)
+	require.NotContains(powers, vals[0].OperatorAddress)
+	require.EqualValues(2, powers[vals[1].OperatorAddress])
+
+	// the tombstone is kept.
+	info, err := f.slashingKeeper.GetValidatorSigningInfo(f.ctx, consAddr)
+	require.NoError(err)
+	require.True(info.Tombstoned)
+
+	gs := f.k.ExportGenesis(f.ctx)
+	require.Equal([]string{vals[0].OperatorAddress}, gs.BannedValidators)
+	require.Equal([]string{consAddr.String()}, gs.BannedConsensusAddresses)
+
+	// the removal is only handled once.
+	f.ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(f.k.HandleTombstonedValidators(f.ctx))
+	require.Empty(f.ctx.EventManager().Events())
+
+	// the banned operator can not be given power again.
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+		Power:            2_000_000,
+		Unsafe:           true,
+	})
+	require.ErrorIs(err, poa.ErrValidatorBanned)
+
+	// a new operator can not be accepted with the banned consensus key.
+	pubKey, err := vals[0].ConsPubKey()
+	require.NoError(err)
+
+	newVal := GenAcc()
+	newValAddr := sdk.ValAddress(newVal.addr).String()
+	require.NoError(f.k.AddPendingValidator(f.ctx, poa.ConvertPOAToStaking(CreateNewValidator("reused", newValAddr, pubKey, 1_000_000)), pubKey))
+
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: newValAddr,
+		Power:            2_000_000,
+		Unsafe:           true,
+	})
+	require.ErrorIs(err, poa.ErrValidatorBanned)
+}
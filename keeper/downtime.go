@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+// The downtime policy replaces the x/slashing downtime jailing, which removes the whole power of a validator and can
+// reduce the liveness of a small set further. The begin blocker reads the missed blocks of the active validators from
+// the x/slashing signing info, after x/slashing updated them for the last commit, and applies the policy action to a
+// validator signing less than the policy minimum of the x/slashing signed blocks window. Its missed blocks are then
+// reset, as x/slashing does when it jails a validator, so the action is applied once per window.
+
+// validateDowntimePolicy checks the chain has x/slashing to read the missed blocks from when the policy is enabled.
+func (k Keeper) validateDowntimePolicy(params poa.Params) error {
+	if params.DowntimePolicy.Action != poa.DowntimeActionUnspecified && k.slashKeeper == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "the downtime policy requires x/slashing")
+	}
+
+	return nil
+}
+
+// ApplyDowntimePolicy applies the downtime policy action to the active validators which missed too many blocks. A
+// validator the action fails for is skipped and evaluated again in the next block.
+func (k Keeper) ApplyDowntimePolicy(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	policy := params.DowntimePolicy
+	if policy.Action == poa.DowntimeActionUnspecified || k.slashKeeper == nil {
+		return nil
+	}
+
+	window, err := k.slashKeeper.SignedBlocksWindow(ctx)
+	if err != nil {
+		return err
+	}
+
+	maxMissed := window - policy.MinSignedPerWindow.MulInt64(window).RoundInt64()
+
+	vals, err := k.activeValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, v := range vals {
+		consAddr, err := v.val.GetConsAddr()
+		if err != nil {
+			return err
+		}
+
+		info, err := k.slashKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		if errors.Is(err, slashingtypes.ErrNoSigningInfoFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		// a new validator is given a full window, as x/slashing does.
+		if info.Tombstoned || sdkCtx.BlockHeight() < info.StartHeight+window || info.MissedBlocksCounter <= maxMissed {
+			continue
+		}
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		newPower, err := k.applyDowntimeAction(cacheCtx, policy, v, info)
+		if err != nil {
+			k.Logger().Error("failed to apply the downtime policy", "validator", v.val.OperatorAddress, "error", err)
+			continue
+		}
+		write()
+
+		if err := sdkCtx.EventManager().EmitTypedEvent(&poa.EventValidatorDowntime{
+			ValidatorAddress: v.val.OperatorAddress,
+			MissedBlocks:     info.MissedBlocksCounter,
+			Action:           policy.Action,
+			OldPower:         v.power,
+			NewPower:         newPower,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyDowntimeAction applies the policy action to a validator and resets its missed blocks. It returns the new
+// consensus power of the validator.
+func (k Keeper) applyDowntimeAction(ctx context.Context, policy poa.DowntimePolicyParams, v activeValidator, info slashingtypes.ValidatorSigningInfo) (int64, error) {
+	consAddr, err := v.val.GetConsAddr()
+	if err != nil {
+		return 0, err
+	}
+
+	newPower := v.power
+
+	switch policy.Action {
+	case poa.DowntimeActionNotify:
+	case poa.DowntimeActionReducePower:
+		newPower = max(v.power-policy.PowerReduction.MulInt64(v.power).TruncateInt64(), 1)
+		if newPower == v.power {
+			break
+		}
+
+		shares := k.stakingKeeper.TokensFromConsensusPower(ctx, newPower).Uint64()
+		if _, err := k.SetPOAPower(ctx, v.val.OperatorAddress, shares); err != nil {
+			return 0, err
+		}
+	case poa.DowntimeActionStandby:
+		// the signing info is cleared with the removal, and set again when the validator is accepted.
+		return 0, k.standbyValidator(ctx, v.val)
+	case poa.DowntimeActionJail:
+		duration, err := k.slashKeeper.DowntimeJailDuration(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		if err := k.stakingKeeper.Jail(ctx, consAddr); err != nil {
+			return 0, err
+		}
+
+		info.JailedUntil = sdk.UnwrapSDKContext(ctx).BlockTime().Add(duration)
+		newPower = 0
+	default:
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown downtime action %s", policy.Action)
+	}
+
+	info.MissedBlocksCounter = 0
+	info.IndexOffset = 0
+	if err := k.slashKeeper.DeleteMissedBlockBitmap(ctx, consAddr); err != nil {
+		return 0, err
+	}
+
+	return newPower, k.slashKeeper.SetValidatorSigningInfo(ctx, consAddr, info)
+}
+
+// standbyValidator removes a validator from the active set and moves it back to the pending validators, the admin
+// accepts it again with MsgSetPower.
+func (k Keeper) standbyValidator(ctx context.Context, val stakingtypes.Validator) error {
+	pubKey, err := val.ConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	val, err = k.SetPOAPower(ctx, val.OperatorAddress, 0)
+	if err != nil {
+		return err
+	}
+
+	if err := k.clearSlashingInfo(ctx, val); err != nil {
+		return err
+	}
+
+	val.Status = stakingtypes.Unbonded
+	return k.AddPendingValidator(ctx, val, pubKey)
+}
@@ -0,0 +1,238 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+func (f *testFixture) setDowntimePolicy(t *testing.T, action poa.DowntimeAction) {
+	t.Helper()
+
+	params := poa.DefaultParams()
+	params.DowntimePolicy.Action = action
+
+	_, err := f.msgServer.UpdateParams(f.ctx, &poa.MsgUpdateParams{Sender: f.authorityAddr, Params: params})
+	require.NoError(t, err)
+}
+
+// setMissedBlocks sets the missed blocks of a validator in the signed blocks window, after its first window.
+func (f *testFixture) setMissedBlocks(t *testing.T, val stakingtypes.Validator, missed int64) sdk.ConsAddress {
+	t.Helper()
+
+	window := slashingtypes.DefaultParams().SignedBlocksWindow
+	f.ctx = f.ctx.WithBlockHeight(2 * window)
+
+	consAddr, err := val.GetConsAddr()
+	require.NoError(t, err)
+
+	require.NoError(t, f.slashingKeeper.SetValidatorSigningInfo(f.ctx, consAddr, slashingtypes.ValidatorSigningInfo{
+		Address:             sdk.ConsAddress(consAddr).String(),
+		IndexOffset:         window,
+		MissedBlocksCounter: missed,
+	}))
+
+	return consAddr
+}
+
+func TestDowntimePolicyParams(t *testing.T) {
+	testCases := []struct {
+		name         string
+		policy       poa.DowntimePolicyParams
+		equalPower   bool
+		expectErrMsg string
+	}{
+		{
+			name:         "fail: unknown action",
+			policy:       poa.DowntimePolicyParams{Action: 10},
+			expectErrMsg: "unknown downtime action",
+		},
+		{
+			name:         "fail: zero min signed",
+			policy:       poa.DowntimePolicyParams{Action: poa.DowntimeActionNotify, MinSignedPerWindow: math.LegacyZeroDec()},
+			expectErrMsg: "min signed per window must be between",
+		},
+		{
+			name:         "fail: full power reduction",
+			policy:       poa.DowntimePolicyParams{Action: poa.DowntimeActionReducePower, MinSignedPerWindow: math.LegacyNewDecWithPrec(5, 1), PowerReduction: math.LegacyOneDec()},
+			expectErrMsg: "power reduction must be between",
+		},
+		{
+			name:         "fail: power reduction in equal power mode",
+			policy:       poa.DowntimePolicyParams{Action: poa.DowntimeActionReducePower, MinSignedPerWindow: math.LegacyNewDecWithPrec(5, 1), PowerReduction: math.LegacyNewDecWithPrec(5, 1)},
+			equalPower:   true,
+			expectErrMsg: "can not be used in hybrid mode or equal power mode",
+		},
+		{
+			name:   "disabled without thresholds",
+			policy: poa.DowntimePolicyParams{},
+		},
+		{
+			name:   "jail without power reduction",
+			policy: poa.DowntimePolicyParams{Action: poa.DowntimeActionJail, MinSignedPerWindow: math.LegacyNewDecWithPrec(5, 1)},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := poa.DefaultParams()
+			params.DowntimePolicy = tc.policy
+			params.EqualPower = poa.EqualPowerParams{Enabled: tc.equalPower, Power: 1_000_000}
+
+			err := params.Validate()
+			if tc.expectErrMsg != "" {
+				require.ErrorContains(t, err, tc.expectErrMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDowntimePolicy(t *testing.T) {
+	testCases := []struct {
+		name        string
+		action      poa.DowntimeAction
+		missed      int64
+		expectPower int64
+		expectEvent bool
+	}{
+		{
+			name:        "disabled",
+			action:      poa.DowntimeActionUnspecified,
+			missed:      100,
+			expectPower: 2,
+		},
+		{
+			name:        "below the threshold",
+			action:      poa.DowntimeActionReducePower,
+			missed:      50,
+			expectPower: 2,
+		},
+		{
+			name:        "notify",
+			action:      poa.DowntimeActionNotify,
+			missed:      51,
+			expectPower: 2,
+			expectEvent: true,
+		},
+		{
+			name:        "reduce power",
+			action:      poa.DowntimeActionReducePower,
+			missed:      51,
+			expectPower: 1,
+			expectEvent: true,
+		},
+		{
+			name:        "standby",
+			action:      poa.DowntimeActionStandby,
+			missed:      51,
+			expectPower: 0,
+			expectEvent: true,
+		},
+		{
+			name:        "jail",
+			action:      poa.DowntimeActionJail,
+			missed:      51,
+			expectPower: 0,
+			expectEvent: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := SetupTest(t, 2_000_000)
+			require := require.New(t)
+
+			vals, err := f.stakingKeeper.GetValidators(f.ctx, 100)
+			require.NoError(err)
+
+			f.setDowntimePolicy(t, tc.action)
+			consAddr := f.setMissedBlocks(t, vals[0], tc.missed)
+
+			f.ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+			require.NoError(f.k.ApplyDowntimePolicy(f.ctx))
+
+			if !tc.expectEvent {
+				require.Empty(f.ctx.EventManager().Events())
+				require.EqualValues(tc.expectPower, f.validatorPowers(t)[vals[0].OperatorAddress])
+				return
+			}
+
+			event := requireTypedEvent[*poa.EventValidatorDowntime](t, f.ctx)
+			require.Equal(vals[0].OperatorAddress, event.ValidatorAddress)
+			require.EqualValues(tc.missed, event.MissedBlocks)
+			require.Equal(tc.action, event.Action)
+			require.EqualValues(2, event.OldPower)
+			require.EqualValues(tc.expectPower, event.NewPower)
+
+			// the missed blocks are reset, the action is applied once per window.
+			info, err := f.slashingKeeper.GetValidatorSigningInfo(f.ctx, consAddr)
+			require.NoError(err)
+			require.Zero(info.MissedBlocksCounter)
+
+			switch tc.action {
+			case poa.DowntimeActionStandby:
+				isPending, err := f.k.IsValidatorPending(f.ctx, vals[0].OperatorAddress)
+				require.NoError(err)
+				require.True(isPending)
+				require.NotContains(f.validatorPowers(t), vals[0].OperatorAddress)
+			case poa.DowntimeActionJail:
+				val, err := f.stakingKeeper.GetValidator(f.ctx, MustValAddressFromBech32(vals[0].OperatorAddress))
+				require.NoError(err)
+				require.True(val.IsJailed())
+				require.True(info.JailedUntil.After(f.ctx.BlockTime()))
+			default:
+				require.EqualValues(tc.expectPower, f.validatorPowers(t)[vals[0].OperatorAddress])
+			}
+		})
+	}
+}
+
+func TestDowntimeStandbyAccept(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 100)
+	require.NoError(err)
+
+	f.setDowntimePolicy(t, poa.DowntimeActionStandby)
+	f.setMissedBlocks(t, vals[0], 100)
+
+	_, err = f.IncreaseBlock(2)
+	require.NoError(err)
+
+	valAddr := MustValAddressFromBech32(vals[0].OperatorAddress)
+	val, err := f.stakingKeeper.GetValidator(f.ctx, valAddr)
+	require.NoError(err)
+	require.True(val.IsUnbonding())
+
+	// the admin accepts the validator on standby again, before its unbonding matured.
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+		Power:            2_000_000,
+		Unsafe:           true,
+	})
+	require.NoError(err)
+
+	f.ctx = f.ctx.WithBlockTime(f.ctx.BlockTime().Add(stakingtypes.DefaultUnbondingTime + time.Hour))
+	_, err = f.IncreaseBlock(2)
+	require.NoError(err)
+
+	val, err = f.stakingKeeper.GetValidator(f.ctx, valAddr)
+	require.NoError(err)
+	require.True(val.IsBonded())
+	require.EqualValues(2, f.validatorPowers(t)[vals[0].OperatorAddress])
+}
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+// In equal power mode every active validator is kept at the same consensus power. The admin only accepts validators
+// into the set with MsgSetPower, or removes them with MsgRemoveValidator, and the begin blocker rebalances the powers
+// of the active validators. A rebalance stays within the power change allowed for a block, so a new validator or a new
+// equal power converges over several blocks on larger sets.
+
+// maxBlockPowerChangePercent is the share of the previous block power that may change in a single block. A larger
+// change would break the trust of the IBC light clients in the validator set.
+const maxBlockPowerChangePercent = 30
+
+// IsEqualPowerMode returns true if the validators are kept at the same consensus power.
+func (k Keeper) IsEqualPowerMode(ctx context.Context) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return params.EqualPower.Enabled, nil
+}
+
+// validateEqualPower checks the equal power of the validators is at least 1 consensus power.
+func (k Keeper) validateEqualPower(ctx context.Context, params poa.Params) error {
+	if !params.EqualPower.Enabled {
+		return nil
+	}
+
+	if k.stakingKeeper.TokensToConsensusPower(ctx, sdkmath.NewIntFromUint64(params.EqualPower.Power)) < 1 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "equal power %d is less than 1 consensus power", params.EqualPower.Power)
+	}
+
+	return nil
+}
+
+// remainingBlockPowerChange returns the consensus power that may still change in the current block.
+func (k Keeper) remainingBlockPowerChange(ctx context.Context) (int64, error) {
+	cachedPower, err := k.GetCachedBlockPower(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	totalChanged, err := k.GetAbsoluteChangedInBlockPower(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if cachedPower == 0 {
+		return 0, nil
+	}
+
+	// the change must stay strictly below the percentage of the cached power.
+	allowed := (cachedPower*maxBlockPowerChangePercent - 1) / 100
+	if totalChanged >= allowed {
+		return 0, nil
+	}
+
+	return int64(allowed - totalChanged), nil
+}
+
+// equalPowerShares returns the shares the admin sets a validator to in equal power mode. A pending validator is
+// accepted with as much of the equal power as the block allows, the rebalance brings it to the equal power after.
+// The power of an active validator can not be set, it may only be removed with MsgRemoveValidator.
+func (k Keeper) equalPowerShares(ctx context.Context, params poa.Params, shares uint64, isPending bool) (uint64, error) {
+	if shares != params.EqualPower.Power {
+		return 0, errorsmod.Wrapf(poa.ErrEqualPowerMode, "power must be the equal power %d, got %d", params.EqualPower.Power, shares)
+	}
+
+	if !isPending {
+		return 0, errorsmod.Wrap(poa.ErrEqualPowerMode, "only pending validators can be accepted")
+	}
+
+	budget, err := k.remainingBlockPowerChange(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if target := k.stakingKeeper.TokensToConsensusPower(ctx, sdkmath.NewIntFromUint64(shares)); budget >= target {
+		return shares, nil
+	}
+
+	// the validator needs some power to be part of the active set the rebalance works on.
+	return k.stakingKeeper.TokensFromConsensusPower(ctx, max(budget, 1)).Uint64(), nil
+}
+
+// RebalanceEqualPower moves the power of the active validators towards the equal power, within the power change
+// allowed for the block. Validators above the equal power are lowered first. A validator which can not be updated,
+// e.g. by the power limit of its organization, is skipped and retried in the next block.
+func (k Keeper) RebalanceEqualPower(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.EqualPower.Enabled {
+		return nil
+	}
+
+	budget, err := k.remainingBlockPowerChange(ctx)
+	if err != nil || budget == 0 {
+		return err
+	}
+
+	target := k.stakingKeeper.TokensToConsensusPower(ctx, sdkmath.NewIntFromUint64(params.EqualPower.Power))
+
+	vals, err := k.activeValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	var above, below []activeValidator
+	for _, v := range vals {
+		switch {
+		case v.power == target:
+			continue
+		case v.power > target:
+			above = append(above, v)
+		default:
+			below = append(below, v)
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, v := range append(above, below...) {
+		if budget == 0 {
+			break
+		}
+
+		step := min(max(target-v.power, -budget), budget)
+		newPower := v.power + step
+
+		shares := params.EqualPower.Power
+		if newPower != target {
+			shares = k.stakingKeeper.TokensFromConsensusPower(ctx, newPower).Uint64()
+		}
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		if _, err := k.SetPOAPower(cacheCtx, v.val.OperatorAddress, shares); err != nil {
+			k.Logger().Error("failed to rebalance equal power", "validator", v.val.OperatorAddress, "error", err)
+			continue
+		}
+		write()
+
+		budget -= max(step, -step)
+
+		cachedPower, err := k.GetCachedBlockPower(ctx)
+		if err != nil {
+			return err
+		}
+
+		totalChanged, err := k.GetAbsoluteChangedInBlockPower(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := sdkCtx.EventManager().EmitTypedEvent(&poa.EventPowerSet{
+			Actor:               authtypes.NewModuleAddress(poa.ModuleName).String(),
+			ValidatorAddress:    v.val.OperatorAddress,
+			OldPower:            v.power,
+			NewPower:            newPower,
+			Shares:              shares,
+			ChangedInBlockPower: totalChanged,
+			CachedBlockPower:    cachedPower,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,241 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+func (f *testFixture) enableEqualPowerMode(t *testing.T, power uint64) {
+	t.Helper()
+
+	params := poa.DefaultParams()
+	params.EqualPower = poa.EqualPowerParams{Enabled: true, Power: power}
+
+	_, err := f.msgServer.UpdateParams(f.ctx, &poa.MsgUpdateParams{Sender: f.authorityAddr, Params: params})
+	require.NoError(t, err)
+}
+
+func (f *testFixture) validatorPowers(t *testing.T) map[string]int64 {
+	t.Helper()
+
+	vals, err := f.stakingKeeper.GetAllValidators(f.ctx)
+	require.NoError(t, err)
+
+	powers := make(map[string]int64, len(vals))
+	for _, val := range vals {
+		valAddr := MustValAddressFromBech32(val.OperatorAddress)
+
+		power, err := f.stakingKeeper.GetLastValidatorPower(f.ctx, valAddr)
+		require.NoError(t, err)
+
+		if power > 0 {
+			powers[val.OperatorAddress] = power
+		}
+	}
+
+	return powers
+}
+
+func TestEqualPowerParams(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	testCases := []struct {
+		name         string
+		equalPower   poa.EqualPowerParams
+		hybridMode   bool
+		expectErrMsg string
+	}{
+		{
+			name:         "fail: enabled without power",
+			equalPower:   poa.EqualPowerParams{Enabled: true},
+			expectErrMsg: "equal power must be positive",
+		},
+		{
+			name:         "fail: less than 1 consensus power",
+			equalPower:   poa.EqualPowerParams{Enabled: true, Power: 500_000},
+			expectErrMsg: "less than 1 consensus power",
+		},
+		{
+			name:         "fail: with hybrid mode",
+			equalPower:   poa.EqualPowerParams{Enabled: true, Power: 1_000_000},
+			hybridMode:   true,
+			expectErrMsg: "can not be enabled together",
+		},
+		{
+			name:       "disabled with power",
+			equalPower: poa.EqualPowerParams{Power: 1_000_000},
+		},
+		{
+			name:       "enabled",
+			equalPower: poa.EqualPowerParams{Enabled: true, Power: 2_000_000},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := poa.DefaultParams()
+			params.EqualPower = tc.equalPower
+			params.HybridMode = tc.hybridMode
+
+			_, err := f.msgServer.UpdateParams(f.ctx, &poa.MsgUpdateParams{Sender: f.authorityAddr, Params: params})
+			if tc.expectErrMsg != "" {
+				require.ErrorContains(err, tc.expectErrMsg)
+				return
+			}
+			require.NoError(err)
+
+			enabled, err := f.k.IsEqualPowerMode(f.ctx)
+			require.NoError(err)
+			require.Equal(tc.equalPower.Enabled, enabled)
+		})
+	}
+}
+
+func TestEqualPowerRebalance(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	require.Len(f.validatorPowers(t), 3)
+
+	// 30% of the 6 power of the set allows 1 power to change per block.
+	f.enableEqualPowerMode(t, 4_000_000)
+
+	f.ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+	_, err := f.IncreaseBlock(1)
+	require.NoError(err)
+
+	// the rebalance is made by the module.
+	powerSet := requireTypedEvent[*poa.EventPowerSet](t, f.ctx)
+	require.Equal(authtypes.NewModuleAddress(poa.ModuleName).String(), powerSet.Actor)
+	require.EqualValues(3_000_000, powerSet.Shares)
+
+	changed := 0
+	for _, power := range f.validatorPowers(t) {
+		if power != 2 {
+			require.EqualValues(3, power)
+			changed++
+		}
+	}
+	require.Equal(1, changed)
+
+	// the set converges to the equal power over the next blocks.
+	for i := 0; i < 10; i++ {
+		_, err := f.IncreaseBlock(1)
+		require.NoError(err)
+	}
+
+	for _, power := range f.validatorPowers(t) {
+		require.EqualValues(4, power)
+	}
+
+	// a lower equal power is rebalanced down as well.
+	f.enableEqualPowerMode(t, 3_000_000)
+
+	for i := 0; i < 10; i++ {
+		_, err := f.IncreaseBlock(1)
+		require.NoError(err)
+	}
+
+	for _, power := range f.validatorPowers(t) {
+		require.EqualValues(3, power)
+	}
+
+	// disabling the mode keeps the powers.
+	_, err = f.msgServer.UpdateParams(f.ctx, &poa.MsgUpdateParams{Sender: f.authorityAddr, Params: poa.DefaultParams()})
+	require.NoError(err)
+
+	_, err = f.IncreaseBlock(2)
+	require.NoError(err)
+
+	for _, power := range f.validatorPowers(t) {
+		require.EqualValues(3, power)
+	}
+}
+
+func TestEqualPowerSetPower(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 100)
+	require.NoError(err)
+
+	f.enableEqualPowerMode(t, 2_000_000)
+	pending := f.CreatePendingValidator("pending", 2_000_000)
+
+	testCases := []struct {
+		name      string
+		validator string
+		power     uint64
+		err       error
+	}{
+		{
+			name:      "fail: set the power of an active validator",
+			validator: vals[0].OperatorAddress,
+			power:     2_000_000,
+			err:       poa.ErrEqualPowerMode,
+		},
+		{
+			name:      "fail: accept with another power",
+			validator: pending.String(),
+			power:     3_000_000,
+			err:       poa.ErrEqualPowerMode,
+		},
+		{
+			name:      "fail: unknown validator",
+			validator: sdk.ValAddress(GenAcc().addr).String(),
+			power:     2_000_000,
+			err:       poa.ErrEqualPowerMode,
+		},
+		{
+			name:      "accept a pending validator",
+			validator: pending.String(),
+			power:     2_000_000,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+				Sender:           f.authorityAddr,
+				ValidatorAddress: tc.validator,
+				Power:            tc.power,
+			})
+			if tc.err != nil {
+				require.ErrorIs(err, tc.err)
+				return
+			}
+			require.NoError(err)
+		})
+	}
+
+	// the accepted validator starts with the power the block allows, then converges to the equal power.
+	require.EqualValues(1, f.validatorPowers(t)[pending.String()])
+
+	_, err = f.IncreaseBlock(1)
+	require.NoError(err)
+	require.EqualValues(2, f.validatorPowers(t)[pending.String()])
+
+	// an active validator may still be removed.
+	_, err = f.msgServer.RemoveValidator(f.ctx, &poa.MsgRemoveValidator{Sender: f.authorityAddr, ValidatorAddress: vals[0].OperatorAddress})
+	require.NoError(err)
+
+	_, err = f.IncreaseBlock(5)
+	require.NoError(err)
+
+	// the removed validator is not brought back by the rebalance.
+	powers := f.validatorPowers(t)
+	require.Len(powers, 3)
+	require.NotContains(powers, vals[0].OperatorAddress)
+	for _, power := range powers {
+		require.EqualValues(2, power)
+	}
+}
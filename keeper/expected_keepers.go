@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
@@ -10,6 +11,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	addresscodec "cosmossdk.io/core/address"
+	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/math"
 )
 
@@ -20,9 +22,12 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+	SetSendEnabled(ctx context.Context, denom string, value bool)
 }
 
 type SlashingKeeper interface {
@@ -30,12 +35,18 @@ type SlashingKeeper interface {
 	SetValidatorSigningInfo(ctx context.Context, address sdk.ConsAddress, info slashingtypes.ValidatorSigningInfo) error
 
 	GetValidatorSigningInfo(ctx context.Context, address sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, error)
+	SignedBlocksWindow(ctx context.Context) (int64, error)
+	DowntimeJailDuration(ctx context.Context) (time.Duration, error)
+	GetParams(ctx context.Context) (slashingtypes.Params, error)
+	SetParams(ctx context.Context, params slashingtypes.Params) error
 }
 
 type StakingKeeper interface {
 	GetValidator(ctx context.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, err error)
 	GetLastValidatorPower(ctx context.Context, operator sdk.ValAddress) (power int64, err error)
+	GetDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, error)
 	SetDelegation(ctx context.Context, delegation stakingtypes.Delegation) error
+	RemoveDelegation(ctx context.Context, delegation stakingtypes.Delegation) error
 	SetValidator(ctx context.Context, validator stakingtypes.Validator) error
 	SetLastValidatorPower(ctx context.Context, operator sdk.ValAddress, power int64) error
 	TokensToConsensusPower(ctx context.Context, tokens math.Int) int64
@@ -43,8 +54,12 @@ type StakingKeeper interface {
 	Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor math.LegacyDec) (math.Int, error)
 	DeleteLastValidatorPower(ctx context.Context, operator sdk.ValAddress) error
 	DeleteValidatorByPowerIndex(ctx context.Context, validator stakingtypes.Validator) error
+	DeleteValidatorQueue(ctx context.Context, val stakingtypes.Validator) error
 	SetNewValidatorByPowerIndex(ctx context.Context, validator stakingtypes.Validator) error
 	SetValidatorByPowerIndex(ctx context.Context, validator stakingtypes.Validator) error
+	ValidatorsPowerStoreIterator(ctx context.Context) (corestore.Iterator, error)
+	Jail(ctx context.Context, consAddr sdk.ConsAddress) error
+	Unjail(ctx context.Context, consAddr sdk.ConsAddress) error
 	SetValidatorByConsAddr(ctx context.Context, validator stakingtypes.Validator) error
 	GetAllValidators(ctx context.Context) (validators []stakingtypes.Validator, err error)
 	PowerReduction(ctx context.Context) math.Int
@@ -59,6 +74,7 @@ type StakingKeeper interface {
 	GetLastTotalPower(ctx context.Context) (math.Int, error)
 	GetBondedValidatorsByPower(ctx context.Context) ([]stakingtypes.Validator, error)
 	GetValidatorUpdates(ctx context.Context) ([]abci.ValidatorUpdate, error)
+	ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates []abci.ValidatorUpdate, err error)
 
 	BeginBlocker(ctx context.Context) error
 	EndBlocker(ctx context.Context) ([]abci.ValidatorUpdate, error)
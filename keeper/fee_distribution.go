@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+// The POA fee distribution pays the transaction fees of a block to the validator operator accounts in the end blocker,
+// before x/distribution allocates them to the delegators in the next begin block. The only delegator of a POA
+// validator is the validator itself, which can not withdraw its rewards while MsgWithdrawDelegatorReward is blocked.
+//
+// The fees are moved from the fee collector to the POA module account. The treasury share is allocated first and the
+// rest is split between the active validators, equally or by consensus power. The last validator is allocated the
+// truncation remainder of the split. Whole coins are paid every block, the fractions accrue to the recipient and are
+// paid once they add up to a coin.
+
+// GetFeeRecipient returns the accrued and paid fees of an account, empty if it was never paid.
+func (k Keeper) GetFeeRecipient(ctx context.Context, address string) (poa.FeeRecipient, error) {
+	recipient, err := k.FeeRecipients.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return poa.FeeRecipient{Address: address}, nil
+	}
+
+	return recipient, err
+}
+
+// DistributeFees splits the fees collected in the block between the treasury and the active validators. Without
+// active validators the fees are left to x/distribution.
+func (k Keeper) DistributeFees(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	dist := params.FeeDistribution
+	if dist.Mode == poa.FeeDistributionModeUnspecified {
+		return nil
+	}
+
+	collected := k.bankKeeper.SpendableCoins(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+	if collected.IsZero() {
+		return nil
+	}
+
+	vals, err := k.activeValidators(ctx)
+	if err != nil || len(vals) == 0 {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, poa.ModuleName, collected); err != nil {
+		return err
+	}
+
+	remaining := sdk.NewDecCoinsFromCoins(collected...)
+
+	var treasury sdk.DecCoins
+	if dist.TreasuryAddress != "" && dist.TreasuryShare.IsPositive() {
+		treasury = remaining.MulDecTruncate(dist.TreasuryShare)
+		if err := k.accrueFees(ctx, dist.TreasuryAddress, treasury); err != nil {
+			return err
+		}
+
+		remaining = remaining.Sub(treasury)
+	}
+
+	var totalWeight int64
+	for _, v := range vals {
+		totalWeight += feeWeight(dist.Mode, v)
+	}
+
+	// the last validator is allocated the rest, so the truncation of the shares leaves no fees unaccounted.
+	var allocated sdk.DecCoins
+	for i, v := range vals {
+		valAddr, err := k.GetValidatorAddressCodec().StringToBytes(v.val.OperatorAddress)
+		if err != nil {
+			return err
+		}
+
+		share := remaining.Sub(allocated)
+		if i < len(vals)-1 {
+			share = remaining.MulDecTruncate(sdkmath.LegacyNewDec(feeWeight(dist.Mode, v))).QuoDecTruncate(sdkmath.LegacyNewDec(totalWeight))
+		}
+
+		if err := k.accrueFees(ctx, sdk.AccAddress(valAddr).String(), share); err != nil {
+			return err
+		}
+
+		allocated = allocated.Add(share...)
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&poa.EventFeesDistributed{
+		Amount:     collected,
+		Treasury:   treasury,
+		Validators: uint32(len(vals)),
+	})
+}
+
+// feeWeight returns the weight of a validator in the split of the fees.
+func feeWeight(mode poa.FeeDistributionMode, v activeValidator) int64 {
+	if mode == poa.FeeDistributionModePower {
+		return v.power
+	}
+
+	return 1
+}
+
+// accrueFees adds fees to the accrued fees of an account and pays out the whole coins. A failed payment, e.g. to a
+// blocked address, stays accrued and is retried with the next fees.
+func (k Keeper) accrueFees(ctx context.Context, address string, amount sdk.DecCoins) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	recipient, err := k.GetFeeRecipient(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	recipient.Accrued = recipient.Accrued.Add(amount...)
+
+	payout, change := recipient.Accrued.TruncateDecimal()
+	if !payout.IsZero() {
+		addr, err := k.GetAccountAddressCodec().StringToBytes(address)
+		if err != nil {
+			return err
+		}
+
+		cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, poa.ModuleName, addr, payout); err != nil {
+			k.Logger().Error("failed to pay fees", "address", address, "error", err)
+		} else {
+			write()
+			recipient.Accrued = change
+			recipient.Paid = recipient.Paid.Add(payout...)
+		}
+	}
+
+	return k.FeeRecipients.Set(ctx, address, recipient)
+}
@@ -0,0 +1,240 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+func (f *testFixture) setFeeDistribution(t *testing.T, dist poa.FeeDistributionParams) {
+	t.Helper()
+
+	params := poa.DefaultParams()
+	params.FeeDistribution = dist
+
+	_, err := f.msgServer.UpdateParams(f.ctx, &poa.MsgUpdateParams{Sender: f.authorityAddr, Params: params})
+	require.NoError(t, err)
+}
+
+// setFeeAccounts sets the fee collector and the accounts paid by the fee distribution.
+func (f *testFixture) setFeeAccounts(t *testing.T, addrs ...sdk.AccAddress) {
+	t.Helper()
+
+	require.NotNil(t, f.accountkeeper.GetModuleAccount(f.ctx, authtypes.FeeCollectorName))
+
+	for _, addr := range addrs {
+		f.accountkeeper.SetAccount(f.ctx, f.accountkeeper.NewAccountWithAddress(f.ctx, addr))
+	}
+}
+
+func (f *testFixture) collectFees(t *testing.T, amt int64) {
+	t.Helper()
+
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(amt)))
+	require.NoError(t, f.bankkeeper.MintCoins(f.ctx, minttypes.ModuleName, coins))
+	require.NoError(t, f.bankkeeper.SendCoinsFromModuleToModule(f.ctx, minttypes.ModuleName, authtypes.FeeCollectorName, coins))
+}
+
+func (f *testFixture) balance(addr sdk.AccAddress) int64 {
+	return f.bankkeeper.GetBalance(f.ctx, addr, "stake").Amount.Int64()
+}
+
+func TestFeeDistributionParams(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	testCases := []struct {
+		name         string
+		dist         poa.FeeDistributionParams
+		expectErrMsg string
+	}{
+		{
+			name:         "fail: nil treasury share",
+			dist:         poa.FeeDistributionParams{Mode: poa.FeeDistributionModeEqual},
+			expectErrMsg: "treasury share cannot be nil",
+		},
+		{
+			name:         "fail: unknown mode",
+			dist:         poa.FeeDistributionParams{Mode: 3, TreasuryShare: sdkmath.LegacyZeroDec()},
+			expectErrMsg: "unknown fee distribution mode",
+		},
+		{
+			name:         "fail: treasury share above 1",
+			dist:         poa.FeeDistributionParams{TreasuryAddress: f.addrs[1].String(), TreasuryShare: sdkmath.LegacyNewDec(2)},
+			expectErrMsg: "treasury share must be between 0 and 1",
+		},
+		{
+			name:         "fail: treasury share without address",
+			dist:         poa.FeeDistributionParams{TreasuryShare: sdkmath.LegacyNewDecWithPrec(1, 1)},
+			expectErrMsg: "requires a treasury address",
+		},
+		{
+			name:         "fail: invalid treasury address",
+			dist:         poa.FeeDistributionParams{TreasuryAddress: "foo", TreasuryShare: sdkmath.LegacyNewDecWithPrec(1, 1)},
+			expectErrMsg: "invalid treasury address",
+		},
+		{
+			name: "power mode with treasury",
+			dist: poa.FeeDistributionParams{
+				Mode:            poa.FeeDistributionModePower,
+				TreasuryAddress: f.addrs[1].String(),
+				TreasuryShare:   sdkmath.LegacyOneDec(),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := poa.DefaultParams()
+			params.FeeDistribution = tc.dist
+
+			err := params.Validate()
+			if tc.expectErrMsg != "" {
+				require.ErrorContains(err, tc.expectErrMsg)
+				return
+			}
+			require.NoError(err)
+		})
+	}
+}
+
+func TestDistributeFees(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 100)
+	require.NoError(err)
+	require.Len(vals, 3)
+
+	operators := make([]sdk.AccAddress, len(vals))
+	for i, val := range vals {
+		operators[i] = sdk.AccAddress(MustValAddressFromBech32(val.OperatorAddress))
+	}
+
+	treasury := f.addrs[1]
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	f.setFeeAccounts(t, append(operators, treasury)...)
+
+	// the fees are left to x/distribution by default.
+	f.collectFees(t, 90)
+	require.NoError(f.appModule.EndBlock(f.ctx))
+	require.EqualValues(90, f.balance(feeCollector))
+
+	// equally split after the treasury share.
+	f.setFeeDistribution(t, poa.FeeDistributionParams{
+		Mode:            poa.FeeDistributionModeEqual,
+		TreasuryAddress: treasury.String(),
+		TreasuryShare:   sdkmath.LegacyNewDecWithPrec(1, 1),
+	})
+
+	f.collectFees(t, 10)
+	require.NoError(f.appModule.EndBlock(f.ctx))
+	require.Zero(f.balance(feeCollector))
+	require.EqualValues(10, f.balance(treasury))
+	for _, operator := range operators {
+		require.EqualValues(30, f.balance(operator))
+	}
+
+	r, err := f.queryServer.FeeRecipient(f.ctx, &poa.QueryFeeRecipientRequest{Address: operators[0].String()})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), r.FeeRecipient.Paid)
+	require.True(r.FeeRecipient.Accrued.IsZero())
+
+	// fractions of a coin accrue until they add up to a coin.
+	f.setFeeDistribution(t, poa.FeeDistributionParams{Mode: poa.FeeDistributionModeEqual, TreasuryShare: sdkmath.LegacyZeroDec()})
+
+	f.collectFees(t, 1)
+	require.NoError(f.appModule.EndBlock(f.ctx))
+	require.EqualValues(30, f.balance(operators[0]))
+
+	r, err = f.queryServer.FeeRecipient(f.ctx, &poa.QueryFeeRecipientRequest{Address: operators[0].String()})
+	require.NoError(err)
+	require.True(r.FeeRecipient.Accrued.AmountOf("stake").GT(sdkmath.LegacyNewDecWithPrec(33, 2)))
+
+	f.collectFees(t, 3)
+	require.NoError(f.appModule.EndBlock(f.ctx))
+	require.EqualValues(31, f.balance(operators[0]))
+
+	// split by consensus power, vals[0] has 4 of the 8 power.
+	f.setFeeDistribution(t, poa.FeeDistributionParams{Mode: poa.FeeDistributionModePower, TreasuryShare: sdkmath.LegacyZeroDec()})
+
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+		Power:            4_000_000,
+		Unsafe:           true,
+	})
+	require.NoError(err)
+
+	before := []int64{f.balance(operators[0]), f.balance(operators[1]), f.balance(operators[2])}
+
+	f.collectFees(t, 80)
+	f.ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(f.appModule.EndBlock(f.ctx))
+	require.EqualValues(40, f.balance(operators[0])-before[0])
+	require.EqualValues(20, f.balance(operators[1])-before[1])
+	require.EqualValues(20, f.balance(operators[2])-before[2])
+
+	res, err := f.queryServer.FeeRecipients(f.ctx, &poa.QueryFeeRecipientsRequest{})
+	require.NoError(err)
+	require.Len(res.FeeRecipients, 4)
+
+	event := requireTypedEvent[*poa.EventFeesDistributed](t, f.ctx)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 80)), event.Amount)
+	require.EqualValues(3, event.Validators)
+}
+
+func TestDistributeFeesRemainder(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 100)
+	require.NoError(err)
+
+	operators := make([]sdk.AccAddress, len(vals))
+	for i, val := range vals {
+		operators[i] = sdk.AccAddress(MustValAddressFromBech32(val.OperatorAddress))
+	}
+
+	treasury := f.addrs[1]
+	f.setFeeAccounts(t, append(operators, treasury)...)
+
+	// a third of the fees to the treasury and a split in three do not divide the fees evenly.
+	f.setFeeDistribution(t, poa.FeeDistributionParams{
+		Mode:            poa.FeeDistributionModeEqual,
+		TreasuryAddress: treasury.String(),
+		TreasuryShare:   sdkmath.LegacyOneDec().QuoInt64(3),
+	})
+
+	poaAddr := authtypes.NewModuleAddress(poa.ModuleName)
+	poaBefore := f.balance(poaAddr)
+
+	var collected int64
+	for _, amt := range []int64{1, 7, 100, 13, 2} {
+		f.collectFees(t, amt)
+		require.NoError(f.appModule.EndBlock(f.ctx))
+		collected += amt
+
+		// the fees paid and accrued add up to the fees collected, and the accrued fees are held by the module.
+		res, err := f.queryServer.FeeRecipients(f.ctx, &poa.QueryFeeRecipientsRequest{})
+		require.NoError(err)
+
+		paid, accrued := sdk.NewCoins(), sdk.NewDecCoins()
+		for _, recipient := range res.FeeRecipients {
+			paid = paid.Add(recipient.Paid...)
+			accrued = accrued.Add(recipient.Accrued...)
+		}
+
+		require.Equal(sdkmath.LegacyNewDec(collected), accrued.AmountOf("stake").Add(sdkmath.LegacyNewDecFromInt(paid.AmountOf("stake"))))
+		require.Equal(sdkmath.LegacyNewDec(f.balance(poaAddr)-poaBefore), accrued.AmountOf("stake"))
+	}
+}
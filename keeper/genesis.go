@@ -2,8 +2,18 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/strangelove-ventures/poa"
 )
 
@@ -17,21 +27,246 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *poa.GenesisState) error
 		found[vals.OperatorAddress] = true
 	}
 
-	if err := k.PendingValidators.Set(ctx, poa.Validators{
-		Validators: data.Vals,
-	}); err != nil {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		return err
+	}
+
+	if err := k.validateDowntimePolicy(data.Params); err != nil {
 		return err
 	}
 
+	if err := k.initVirtualPower(ctx, data.Params.VirtualPower); err != nil {
+		return err
+	}
+
+	for _, val := range data.Vals {
+		if err := k.PendingValidators.Set(ctx, val.OperatorAddress, val); err != nil {
+			return err
+		}
+	}
+
 	if err := k.CachedBlockPower.Set(ctx, poa.PowerCache{
-		Power: 0,
+		Power: data.CachedBlockPower,
 	}); err != nil {
 		return err
 	}
 
-	return k.AbsoluteChangedInBlockPower.Set(ctx, poa.PowerCache{
-		Power: 0,
-	})
+	if err := k.AbsoluteChangedInBlockPower.Set(ctx, poa.PowerCache{
+		Power: data.AbsoluteChangedInBlockPower,
+	}); err != nil {
+		return err
+	}
+
+	for _, valAddr := range data.UpdatedValidators {
+		if err := k.UpdatedValidatorsCache.Set(ctx, valAddr); err != nil {
+			return err
+		}
+	}
+
+	for _, org := range data.Organizations {
+		if err := k.SetOrganization(ctx, org); err != nil {
+			return err
+		}
+	}
+
+	for _, vo := range data.ValidatorOrganizations {
+		if err := k.ValidatorOrganization.Set(ctx, vo.ValidatorAddress, vo.OrganizationId); err != nil {
+			return err
+		}
+
+		if err := k.OrganizationValidators.Set(ctx, collections.Join(vo.OrganizationId, vo.ValidatorAddress)); err != nil {
+			return err
+		}
+	}
+
+	for _, op := range data.OrganizationPowers {
+		if err := k.OrganizationPower.Set(ctx, op.OrganizationId, op.Power); err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range data.AuditLog {
+		if err := k.AuditLog.Set(ctx, entry.Id, entry); err != nil {
+			return err
+		}
+	}
+
+	for _, vp := range data.AdminPowers {
+		if err := k.AdminPower.Set(ctx, vp.ValidatorAddress, vp.Power); err != nil {
+			return err
+		}
+	}
+
+	for _, account := range data.AllowedAccounts {
+		if err := k.AllowedAccounts.Set(ctx, account); err != nil {
+			return err
+		}
+	}
+
+	for _, prefix := range data.AllowedPrefixes {
+		if err := k.AllowedPrefixes.Set(ctx, prefix); err != nil {
+			return err
+		}
+	}
+
+	if err := k.MaintenanceMode.Set(ctx, data.MaintenanceMode); err != nil {
+		return err
+	}
+
+	if err := k.Paused.Set(ctx, data.Paused); err != nil {
+		return err
+	}
+
+	for _, recipient := range data.FeeRecipients {
+		if err := k.FeeRecipients.Set(ctx, recipient.Address, recipient); err != nil {
+			return err
+		}
+	}
+
+	for _, operator := range data.BannedValidators {
+		if err := k.BannedValidators.Set(ctx, operator); err != nil {
+			return err
+		}
+	}
+
+	for _, consAddr := range data.BannedConsensusAddresses {
+		if err := k.BannedConsensusAddresses.Set(ctx, consAddr); err != nil {
+			return err
+		}
+	}
+
+	for _, operator := range data.SlashedValidators {
+		valAddr, err := k.GetValidatorAddressCodec().StringToBytes(operator)
+		if err != nil {
+			return err
+		}
+
+		if err := k.SlashedValidators.Set(ctx, valAddr); err != nil {
+			return err
+		}
+	}
+
+	// the authorities are recorded in the next begin block if they were not yet.
+	if data.Authorities != nil {
+		if err := k.Authorities.Set(ctx, *data.Authorities); err != nil {
+			return err
+		}
+	}
+
+	// untracked bonded tokens are reconciled from the delegations when they are first read.
+	if data.BondedTokens != nil {
+		if err := k.BondedTokens.Set(ctx, *data.BondedTokens); err != nil {
+			return err
+		}
+	}
+
+	return k.AuditLogSequence.Set(ctx, data.AuditLogSequence)
+}
+
+// InitGenesisValidators installs the genesis validators into the x/staking active set with their POA power, in place
+// of gentxs, and returns the resulting validator set updates.
+func (k *Keeper) InitGenesisValidators(ctx context.Context, vals []poa.GenesisValidator) ([]abci.ValidatorUpdate, error) {
+	if len(vals) == 0 {
+		return nil, nil
+	}
+
+	// only one module may return the genesis validator set.
+	lastTotalPower, err := k.stakingKeeper.GetLastTotalPower(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !lastTotalPower.IsZero() {
+		return nil, fmt.Errorf("poa genesis validators can not be combined with gentxs or an existing x/staking validator set")
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	totalTokens := sdkmath.ZeroInt()
+
+	for _, gv := range vals {
+		pk, ok := gv.ConsensusPubkey.GetCachedValue().(cryptotypes.PubKey)
+		if !ok {
+			return nil, fmt.Errorf("issue getting consensus pubkey for %s", gv.OperatorAddress)
+		}
+
+		val, err := stakingtypes.NewValidator(gv.OperatorAddress, pk, stakingtypes.NewDescription(
+			gv.Description.Moniker,
+			gv.Description.Identity,
+			gv.Description.Website,
+			gv.Description.SecurityContact,
+			gv.Description.Details,
+		))
+		if err != nil {
+			return nil, err
+		}
+
+		val, err = val.SetInitialCommission(stakingtypes.NewCommissionWithTime(
+			gv.Commission.Rate, gv.Commission.MaxRate,
+			gv.Commission.MaxChangeRate, sdkCtx.BlockHeader().Time,
+		))
+		if err != nil {
+			return nil, err
+		}
+
+		amt := sdkmath.NewIntFromUint64(gv.Power)
+		if params.HybridMode {
+			if err := k.AdminPower.Set(ctx, gv.OperatorAddress, gv.Power); err != nil {
+				return nil, err
+			}
+
+			amt = hybridSelfTokens(params, gv.Power)
+		}
+
+		val.MinSelfDelegation = sdkmath.NewInt(1)
+		val.Tokens = amt
+		val.DelegatorShares = sdkmath.LegacyNewDecFromInt(amt)
+
+		// setup the validator into the state, x/staking bonds it when applying the validator set updates.
+		if err := k.setValidatorInternals(ctx, val); err != nil {
+			return nil, err
+		}
+
+		// the signing info tracks the liveness of the validator from genesis, as for a validator accepted by POA.
+		if err := k.setSlashingInfo(sdkCtx, val); err != nil {
+			return nil, err
+		}
+
+		valAddr, err := k.GetValidatorAddressCodec().StringToBytes(val.OperatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		delAddr := sdk.AccAddress(valAddr)
+
+		if err := k.stakingKeeper.Hooks().BeforeDelegationCreated(ctx, delAddr, valAddr); err != nil {
+			return nil, err
+		}
+
+		if err := k.stakingKeeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddr.String(), val.OperatorAddress, val.DelegatorShares)); err != nil {
+			return nil, err
+		}
+
+		if err := k.stakingKeeper.Hooks().AfterDelegationModified(ctx, delAddr, valAddr); err != nil {
+			return nil, err
+		}
+
+		if err := k.stakingKeeper.SetValidatorByPowerIndex(ctx, val); err != nil {
+			return nil, err
+		}
+
+		totalTokens = totalTokens.Add(amt)
+	}
+
+	// the unbonded validator tokens are moved into the bonded pool by x/staking.
+	if err := k.adjustPool(ctx, stakingtypes.NotBondedPoolName, totalTokens); err != nil {
+		return nil, err
+	}
+
+	return k.stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
 }
 
 // InitStores sets the `AbsoluteChangedBlock` and `PreviousBlockPower` as a cache into the poa store.
@@ -55,12 +290,184 @@ func (k *Keeper) InitCacheStores(ctx context.Context) error {
 
 // ExportGenesis exports the module's state to a genesis state.
 func (k *Keeper) ExportGenesis(ctx context.Context) *poa.GenesisState {
-	vals, err := k.PendingValidators.Get(ctx)
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	vals, err := k.GetPendingValidators(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// required to unpack the pubKeys properly for validation.
+	for _, val := range vals.Validators {
+		if err := val.UnpackInterfaces(k.cdc); err != nil {
+			panic(err)
+		}
+	}
+
+	cachedPower, err := k.GetCachedBlockPower(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	changedPower, err := k.GetAbsoluteChangedInBlockPower(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var updatedVals []string
+	if err := k.UpdatedValidatorsCache.Walk(ctx, nil, func(valAddr string) (bool, error) {
+		updatedVals = append(updatedVals, valAddr)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+
+	var orgs []poa.Organization
+	if err := k.Organizations.Walk(ctx, nil, func(_ string, org poa.Organization) (bool, error) {
+		orgs = append(orgs, org)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+
+	var valOrgs []poa.ValidatorOrganization
+	if err := k.ValidatorOrganization.Walk(ctx, nil, func(valAddr, orgID string) (bool, error) {
+		valOrgs = append(valOrgs, poa.ValidatorOrganization{ValidatorAddress: valAddr, OrganizationId: orgID})
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+
+	var orgPowers []poa.OrganizationPower
+	if err := k.OrganizationPower.Walk(ctx, nil, func(orgID string, power int64) (bool, error) {
+		orgPowers = append(orgPowers, poa.OrganizationPower{OrganizationId: orgID, Power: power})
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+
+	var auditLog []poa.AuditLogEntry
+	if err := k.AuditLog.Walk(ctx, nil, func(_ uint64, entry poa.AuditLogEntry) (bool, error) {
+		auditLog = append(auditLog, entry)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+
+	auditLogSeq, err := k.AuditLogSequence.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var adminPowers []poa.ValidatorPower
+	if err := k.AdminPower.Walk(ctx, nil, func(valAddr string, power uint64) (bool, error) {
+		adminPowers = append(adminPowers, poa.ValidatorPower{ValidatorAddress: valAddr, Power: power})
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+
+	var allowedAccounts []string
+	if err := k.AllowedAccounts.Walk(ctx, nil, func(account string) (bool, error) {
+		allowedAccounts = append(allowedAccounts, account)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+
+	var allowedPrefixes []string
+	if err := k.AllowedPrefixes.Walk(ctx, nil, func(prefix string) (bool, error) {
+		allowedPrefixes = append(allowedPrefixes, prefix)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+
+	maintenanceMode, err := k.GetMaintenanceMode(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	paused, err := k.IsPaused(ctx)
 	if err != nil {
 		panic(err)
 	}
 
+	var feeRecipients []poa.FeeRecipient
+	if err := k.FeeRecipients.Walk(ctx, nil, func(_ string, recipient poa.FeeRecipient) (bool, error) {
+		feeRecipients = append(feeRecipients, recipient)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+
+	var bannedValidators []string
+	if err := k.BannedValidators.Walk(ctx, nil, func(operator string) (bool, error) {
+		bannedValidators = append(bannedValidators, operator)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+
+	var bannedConsAddrs []string
+	if err := k.BannedConsensusAddresses.Walk(ctx, nil, func(consAddr string) (bool, error) {
+		bannedConsAddrs = append(bannedConsAddrs, consAddr)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+
+	var slashedVals []string
+	if err := k.SlashedValidators.Walk(ctx, nil, func(valAddr sdk.ValAddress) (bool, error) {
+		operator, err := k.GetValidatorAddressCodec().BytesToString(valAddr)
+		if err != nil {
+			return true, err
+		}
+
+		slashedVals = append(slashedVals, operator)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+
+	var bondedTokens *sdkmath.Int
+	if total, err := k.BondedTokens.Get(ctx); err == nil {
+		bondedTokens = &total
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+
+	var authorities *poa.Authorities
+	if recorded, err := k.Authorities.Get(ctx); err == nil {
+		authorities = &recorded
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+
 	return &poa.GenesisState{
-		Vals: vals.Validators,
+		Params:                      params,
+		Vals:                        vals.Validators,
+		CachedBlockPower:            cachedPower,
+		AbsoluteChangedInBlockPower: changedPower,
+		UpdatedValidators:           updatedVals,
+		Organizations:               orgs,
+		ValidatorOrganizations:      valOrgs,
+		OrganizationPowers:          orgPowers,
+		AuditLog:                    auditLog,
+		AuditLogSequence:            auditLogSeq,
+		AdminPowers:                 adminPowers,
+		AllowedAccounts:             allowedAccounts,
+		AllowedPrefixes:             allowedPrefixes,
+		MaintenanceMode:             maintenanceMode,
+		Paused:                      paused,
+		FeeRecipients:               feeRecipients,
+		BannedValidators:            bannedValidators,
+		BannedConsensusAddresses:    bannedConsAddrs,
+		BondedTokens:                bondedTokens,
+		SlashedValidators:           slashedVals,
+		Authorities:                 authorities,
 	}
 }
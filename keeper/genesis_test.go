@@ -5,12 +5,19 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/strangelove-ventures/poa"
+	"github.com/strangelove-ventures/poa/keeper"
 )
 
 func TestInitGenesis(t *testing.T) {
@@ -18,13 +25,14 @@ func TestInitGenesis(t *testing.T) {
 	require := require.New(t)
 
 	t.Run("default state", func(t *testing.T) {
-		data := &poa.GenesisState{}
+		data := poa.NewGenesisState()
 		err := fixture.k.InitGenesis(fixture.ctx, data)
 		require.NoError(err)
 	})
 
 	t.Run("duplicate validators found in state", func(t *testing.T) {
 		data := &poa.GenesisState{
+			Params: poa.DefaultParams(),
 			Vals: []poa.Validator{{
 				OperatorAddress: "cosmos1abc",
 			}, {
@@ -45,7 +53,8 @@ func TestInitGenesis(t *testing.T) {
 		val.Tokens = sdkmath.NewInt(1_234_567)
 
 		state := &poa.GenesisState{
-			Vals: []poa.Validator{poa.ConvertStakingToPOA(val)},
+			Params: poa.DefaultParams(),
+			Vals:   []poa.Validator{poa.ConvertStakingToPOA(val)},
 		}
 
 		err = fixture.k.InitGenesis(fixture.ctx, state)
@@ -57,3 +66,190 @@ func TestInitGenesis(t *testing.T) {
 		require.Equal(state.Vals[0].OperatorAddress, exported.Vals[0].OperatorAddress)
 	})
 }
+
+func TestGenesisRoundTrip(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 100)
+	require.NoError(err)
+
+	// populate every store of the module.
+	f.CreatePendingValidator("pending", 1_000_000)
+
+	_, err = f.msgServer.RegisterOrganization(f.ctx, &poa.MsgRegisterOrganization{
+		Sender:       f.authorityAddr,
+		Organization: poa.NewOrganization("acme", "Acme Corp", sdkmath.LegacyNewDecWithPrec(5, 1)),
+	})
+	require.NoError(err)
+
+	_, err = f.msgServer.SetValidatorOrganization(f.ctx, &poa.MsgSetValidatorOrganization{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+		OrganizationId:   "acme",
+	})
+	require.NoError(err)
+
+	// updates the power caches and the updated validators cache within the block.
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[1].OperatorAddress,
+		Power:            3_000_000,
+	})
+	require.NoError(err)
+
+	_, err = f.msgServer.UpdateAccountAllowlist(f.ctx, &poa.MsgUpdateAccountAllowlist{
+		Sender:      f.authorityAddr,
+		AddAccounts: []string{f.addrs[1].String()},
+		AddPrefixes: []string{"cosmos1qqq"},
+	})
+	require.NoError(err)
+
+	_, err = f.msgServer.SetMaintenanceMode(f.ctx, &poa.MsgSetMaintenanceMode{
+		Sender:          f.authorityAddr,
+		MaintenanceMode: poa.MaintenanceMode{Enabled: true, AllowedMsgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+	})
+	require.NoError(err)
+
+	require.NoError(f.k.Paused.Set(f.ctx, true))
+
+	recipient := poa.FeeRecipient{Address: f.addrs[2].String(), Paid: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}
+	require.NoError(f.k.FeeRecipients.Set(f.ctx, recipient.Address, recipient))
+
+	require.NoError(f.k.AuditAuthorityChange(f.ctx))
+
+	// a slash tracked by the hooks is pending until the next begin block.
+	require.NoError(f.k.Hooks().BeforeValidatorModified(f.ctx, MustValAddressFromBech32(vals[2].OperatorAddress)))
+
+	exported := f.k.ExportGenesis(f.ctx)
+	require.NoError(exported.Validate())
+	require.Len(exported.Vals, 1)
+	require.NotZero(exported.CachedBlockPower)
+	require.NotZero(exported.AbsoluteChangedInBlockPower)
+	require.Equal([]string{vals[1].OperatorAddress}, exported.UpdatedValidators)
+	require.Len(exported.Organizations, 1)
+	require.Len(exported.ValidatorOrganizations, 1)
+	require.Equal([]poa.OrganizationPower{{OrganizationId: "acme", Power: 2}}, exported.OrganizationPowers)
+	require.Equal([]string{f.addrs[1].String()}, exported.AllowedAccounts)
+	require.Equal([]string{"cosmos1qqq"}, exported.AllowedPrefixes)
+	require.True(exported.MaintenanceMode.Enabled)
+	require.True(exported.Paused)
+	require.Equal([]poa.FeeRecipient{recipient}, exported.FeeRecipients)
+	require.Equal([]string{vals[2].OperatorAddress}, exported.SlashedValidators)
+	require.NotNil(exported.BondedTokens)
+	require.True(exported.BondedTokens.IsPositive())
+	require.Equal(&poa.Authorities{Authority: f.authorityAddr}, exported.Authorities)
+	require.NotEmpty(exported.AuditLog)
+	require.EqualValues(len(exported.AuditLog), exported.AuditLogSequence)
+
+	// the exported JSON passes the module genesis validation.
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	registerModuleInterfaces(encCfg)
+
+	bz := encCfg.Codec.MustMarshalJSON(exported)
+	require.NoError(f.appModule.ValidateGenesis(encCfg.Codec, nil, bz))
+
+	// import into an empty store and export again.
+	key := storetypes.NewKVStoreKey("poa_import")
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_import")).Ctx
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), f.stakingKeeper, f.slashingKeeper, f.bankkeeper, log.NewNopLogger(), authorityAddr)
+
+	var imported poa.GenesisState
+	encCfg.Codec.MustUnmarshalJSON(bz, &imported)
+	require.NoError(k.InitGenesis(ctx, &imported))
+
+	require.Equal(string(bz), string(encCfg.Codec.MustMarshalJSON(k.ExportGenesis(ctx))))
+
+	// the imported audit log continues its sequence.
+	id, err := k.AuditLogSequence.Peek(ctx)
+	require.NoError(err)
+	require.EqualValues(exported.AuditLogSequence, id)
+
+	// the imported bonded tokens are tracked without a reconciliation.
+	bondedTokens, err := k.BondedTokens.Get(ctx)
+	require.NoError(err)
+	require.Equal(*exported.BondedTokens, bondedTokens)
+}
+
+func TestInitGenesisValidators(t *testing.T) {
+	require := require.New(t)
+
+	newGenesisValidator := func(power uint64) (poa.GenesisValidator, sdk.ValAddress) {
+		acc := GenAcc()
+		valAddr := sdk.ValAddress(acc.addr)
+
+		pkAny, err := codectypes.NewAnyWithValue(acc.valKey.PubKey())
+		require.NoError(err)
+
+		return poa.GenesisValidator{
+			OperatorAddress: valAddr.String(),
+			ConsensusPubkey: pkAny,
+			Description:     poa.NewDescription("genesis", "", "", "", ""),
+			Commission:      poa.NewCommissionRates(sdkmath.LegacyNewDecWithPrec(1, 1), sdkmath.LegacyNewDecWithPrec(2, 1), sdkmath.LegacyNewDecWithPrec(1, 2)),
+			Power:           power,
+		}, valAddr
+	}
+
+	t.Run("installs the validators into the active set", func(t *testing.T) {
+		f := SetupTest(t, 0)
+
+		gv1, valAddr1 := newGenesisValidator(1_000_000)
+		gv2, valAddr2 := newGenesisValidator(3_000_000)
+
+		updates, err := f.k.InitGenesisValidators(f.ctx, []poa.GenesisValidator{gv1, gv2})
+		require.NoError(err)
+		require.Len(updates, 2)
+
+		for valAddr, power := range map[string]int64{valAddr1.String(): 1, valAddr2.String(): 3} {
+			bz, err := sdk.ValAddressFromBech32(valAddr)
+			require.NoError(err)
+
+			val, err := f.stakingKeeper.GetValidator(f.ctx, bz)
+			require.NoError(err)
+			require.True(val.IsBonded())
+			require.Equal("genesis", val.GetMoniker())
+			require.EqualValues(power*1_000_000, val.Tokens.Int64())
+
+			lastPower, err := f.stakingKeeper.GetLastValidatorPower(f.ctx, bz)
+			require.NoError(err)
+			require.Equal(power, lastPower)
+
+			del, err := f.stakingKeeper.GetDelegation(f.ctx, sdk.AccAddress(bz), bz)
+			require.NoError(err)
+			require.Equal(val.DelegatorShares, del.Shares)
+
+			// the slashing signing info is set up for the downtime tracking.
+			consAddr, err := val.GetConsAddr()
+			require.NoError(err)
+			info, err := f.slashingKeeper.GetValidatorSigningInfo(f.ctx, consAddr)
+			require.NoError(err)
+			require.Equal(sdk.ConsAddress(consAddr).String(), info.Address)
+			require.Equal(f.ctx.BlockHeight(), info.StartHeight)
+			require.False(info.Tombstoned)
+		}
+
+		totalPower, err := f.stakingKeeper.GetLastTotalPower(f.ctx)
+		require.NoError(err)
+		require.EqualValues(4, totalPower.Int64())
+
+		bondedPool := f.stakingKeeper.GetBondedPool(f.ctx)
+		require.EqualValues(4_000_000, f.bankkeeper.GetBalance(f.ctx, bondedPool.GetAddress(), "stake").Amount.Int64())
+	})
+
+	t.Run("no validators", func(t *testing.T) {
+		f := SetupTest(t, 0)
+
+		updates, err := f.k.InitGenesisValidators(f.ctx, nil)
+		require.NoError(err)
+		require.Empty(updates)
+	})
+
+	t.Run("existing validator set", func(t *testing.T) {
+		f := SetupTest(t, 2_000_000)
+
+		gv, _ := newGenesisValidator(1_000_000)
+
+		_, err := f.k.InitGenesisValidators(f.ctx, []poa.GenesisValidator{gv})
+		require.Error(err)
+	})
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// Hooks are the x/staking hooks of POA. x/evidence slashes a double signing validator through x/staking before it
+// tombstones it, so the slashed validators are tracked and checked for a tombstone in the next begin block, see
+// HandleTombstonedValidators.
+type Hooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+// Hooks returns the x/staking hooks of POA.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BeforeValidatorModified tracks the validators slashed by x/staking. It is called before a slash, even with a 0 slash
+// fraction.
+func (h Hooks) BeforeValidatorModified(ctx context.Context, valAddr sdk.ValAddress) error {
+	if h.k.slashKeeper == nil {
+		return nil
+	}
+
+	return h.k.SlashedValidators.Set(ctx, valAddr)
+}
+
+func (h Hooks) AfterValidatorCreated(context.Context, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorRemoved(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBonded(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBeginUnbonding(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+// BeforeDelegationCreated records a delegation before it is created, see AfterDelegationModified.
+func (h Hooks) BeforeDelegationCreated(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.recordModifiedDelegation(ctx, delAddr, valAddr)
+}
+
+// BeforeDelegationSharesModified records a delegation before it is modified, see AfterDelegationModified.
+func (h Hooks) BeforeDelegationSharesModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.recordModifiedDelegation(ctx, delAddr, valAddr)
+}
+
+// BeforeDelegationRemoved checks a delegation fully unbonded in hybrid mode, see AfterDelegationModified.
+func (h Hooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.checkModifiedDelegation(ctx, delAddr, valAddr, true)
+}
+
+// AfterDelegationModified checks a delegation added to in hybrid mode, including the delegations executed by x/gov or
+// x/group which skip the ante handler.
+func (h Hooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.checkModifiedDelegation(ctx, delAddr, valAddr, false)
+}
+
+func (h Hooks) BeforeValidatorSlashed(context.Context, sdk.ValAddress, sdkmath.LegacyDec) error {
+	return nil
+}
+
+func (h Hooks) AfterUnbondingInitiated(context.Context, uint64) error {
+	return nil
+}
@@ -0,0 +1,672 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+// In hybrid mode the admin assigned power of a validator is held by its POA self delegation, next to the delegations
+// of token holders. Delegated stake counts fully towards the validator tokens, so the self delegation is scaled by
+// weight / (1 - weight) for the tokens to be proportional to `weight * admin power + (1 - weight) * delegated stake`.
+//
+// Unlike the POA only mode, x/staking computes the validator set updates from the tokens of the validators. The power
+// index holds a single entry per validator and the last validator power is left to x/staking.
+
+// hybridSelfTokens returns the tokens of the POA self delegation for the admin assigned power.
+func hybridSelfTokens(params poa.Params, adminPower uint64) sdkmath.Int {
+	w := params.AdminPowerWeight
+
+	return sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(adminPower)).
+		Mul(w).
+		Quo(sdkmath.LegacyOneDec().Sub(w)).
+		TruncateInt()
+}
+
+// maxDelegatedTokens returns the maximum delegated power in tokens, it is set with a 10^6 precision whatever the power
+// reduction of the chain.
+func maxDelegatedTokens(params poa.Params, powerReduction sdkmath.Int) sdkmath.Int {
+	return sdkmath.NewIntFromUint64(params.MaxDelegatedPower).Mul(powerReduction).QuoRaw(1_000_000)
+}
+
+// IsHybridMode returns true if token holders may delegate to the validators the admin assigned power to.
+func (k Keeper) IsHybridMode(ctx context.Context) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return params.HybridMode, nil
+}
+
+// ValidateHybridStakingMsg returns an error if the x/staking message is not allowed. Token holders may only delegate
+// to, undelegate from and redelegate between validators with an admin assigned power in hybrid mode, up to the
+// maximum delegated power of the validator.
+func (k Keeper) ValidateHybridStakingMsg(ctx context.Context, msg sdk.Msg) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.HybridMode {
+		return poa.ErrStakingActionNotAllowed
+	}
+
+	switch msg := msg.(type) {
+	case *stakingtypes.MsgDelegate:
+		if err := k.checkSelfDelegator(ctx, msg.DelegatorAddress, msg.ValidatorAddress); err != nil {
+			return err
+		}
+
+		return k.checkDelegationAllowed(ctx, params, msg.ValidatorAddress, msg.Amount.Amount)
+	case *stakingtypes.MsgBeginRedelegate:
+		if err := k.checkSelfDelegator(ctx, msg.DelegatorAddress, msg.ValidatorSrcAddress); err != nil {
+			return err
+		}
+
+		return k.checkDelegationAllowed(ctx, params, msg.ValidatorDstAddress, msg.Amount.Amount)
+	case *stakingtypes.MsgCancelUnbondingDelegation:
+		if err := k.checkSelfDelegator(ctx, msg.DelegatorAddress, msg.ValidatorAddress); err != nil {
+			return err
+		}
+
+		return k.checkDelegationAllowed(ctx, params, msg.ValidatorAddress, msg.Amount.Amount)
+	case *stakingtypes.MsgUndelegate:
+		return k.checkSelfDelegator(ctx, msg.DelegatorAddress, msg.ValidatorAddress)
+	default:
+		return poa.ErrStakingActionNotAllowed
+	}
+}
+
+// checkSelfDelegator returns an error if the delegator is the validator operator, whose self delegation is managed
+// by POA.
+func (k Keeper) checkSelfDelegator(ctx context.Context, delegator, validator string) error {
+	isSelf, err := k.IsSenderValidator(ctx, delegator, validator)
+	if err != nil {
+		return err
+	}
+
+	if isSelf {
+		return errorsmod.Wrapf(poa.ErrStakingActionNotAllowed, "the self delegation of %s is managed by the admin", validator)
+	}
+
+	return nil
+}
+
+// checkDelegationAllowed returns an error if the validator has no admin assigned power, or if the amount would take
+// the stake delegated to it over the maximum delegated power.
+func (k Keeper) checkDelegationAllowed(ctx context.Context, params poa.Params, validator string, amount sdkmath.Int) error {
+	if has, err := k.AdminPower.Has(ctx, validator); err != nil {
+		return err
+	} else if !has {
+		return errorsmod.Wrapf(poa.ErrValidatorNotAuthorized, "validator %s", validator)
+	}
+
+	if params.MaxDelegatedPower == 0 {
+		return nil
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	val, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	delegated, err := k.delegatedTokens(ctx, val)
+	if err != nil {
+		return err
+	}
+
+	maxDelegated := maxDelegatedTokens(params, k.stakingKeeper.PowerReduction(ctx))
+	if delegated.Add(amount).GT(maxDelegated) {
+		return errorsmod.Wrapf(poa.ErrDelegationCapExceeded, "validator %s has %s delegated of %s", validator, delegated, maxDelegated)
+	}
+
+	return nil
+}
+
+// recordModifiedDelegation records a delegation before x/staking modifies it in hybrid mode, a new delegation is
+// recorded without shares.
+func (k Keeper) recordModifiedDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	// x/staking may init its genesis delegations before the params are set.
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) || (err == nil && !params.HybridMode) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	del, err := k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	if errors.Is(err, stakingtypes.ErrNoDelegation) {
+		del = stakingtypes.Delegation{Shares: sdkmath.LegacyZeroDec()}
+	} else if err != nil {
+		return err
+	}
+
+	return k.ModifiedDelegations.Set(ctx, collections.Join(delAddr, valAddr), del)
+}
+
+// checkModifiedDelegation enforces the delegation rules of ValidateHybridStakingMsg on a delegation x/staking modified.
+// The validator operator can not change its POA self delegation, and a delegation which grew is checked against the
+// admin power and the maximum delegated power. An undelegation is otherwise always allowed, so token holders can
+// leave a validator which lost its admin assigned power.
+func (k Keeper) checkModifiedDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, removed bool) error {
+	key := collections.Join(delAddr, valAddr)
+
+	prev, err := k.ModifiedDelegations.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.ModifiedDelegations.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	// a removed delegation is still stored with its previous shares.
+	shares := sdkmath.LegacyZeroDec()
+	if !removed {
+		del, err := k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+		if err != nil {
+			return err
+		}
+		shares = del.Shares
+	}
+
+	// the hooks also run for unchanged delegations, as when x/distribution starts to track them.
+	if shares.Equal(prev.Shares) {
+		return nil
+	}
+
+	val, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := k.checkSelfDelegator(ctx, delAddr.String(), val.OperatorAddress); err != nil {
+		return err
+	}
+
+	if shares.LT(prev.Shares) {
+		return nil
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	// x/staking adds the tokens of the delegation to the validator before the after delegation hook.
+	return k.checkDelegationAllowed(ctx, params, val.OperatorAddress, sdkmath.ZeroInt())
+}
+
+// selfDelegation returns the POA self delegation of the validator, if any.
+func (k Keeper) selfDelegation(ctx context.Context, valAddr sdk.ValAddress) (stakingtypes.Delegation, bool, error) {
+	del, err := k.stakingKeeper.GetDelegation(ctx, sdk.AccAddress(valAddr), valAddr)
+	if errors.Is(err, stakingtypes.ErrNoDelegation) {
+		return stakingtypes.Delegation{}, false, nil
+	} else if err != nil {
+		return stakingtypes.Delegation{}, false, err
+	}
+
+	return del, true, nil
+}
+
+// delegatedTokens returns the validator tokens delegated by token holders, without the POA self delegation.
+func (k Keeper) delegatedTokens(ctx context.Context, val stakingtypes.Validator) (sdkmath.Int, error) {
+	valAddr, err := k.GetValidatorAddressCodec().StringToBytes(val.OperatorAddress)
+	if err != nil {
+		return sdkmath.Int{}, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	del, found, err := k.selfDelegation(ctx, valAddr)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	if !found || val.DelegatorShares.IsZero() {
+		return val.Tokens, nil
+	}
+
+	return val.Tokens.Sub(val.TokensFromShares(del.Shares).TruncateInt()), nil
+}
+
+// setPOAPowerHybrid sets the admin assigned power of a validator in hybrid mode. A power of 0 removes the POA self
+// delegation and jails the validator, the delegations of token holders unbond through x/staking.
+func (k Keeper) setPOAPowerHybrid(ctx context.Context, params poa.Params, valOpBech32 string, newShares uint64) (stakingtypes.Validator, error) {
+	valAddr, err := sdk.ValAddressFromBech32(valOpBech32)
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	val, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	prevShares, err := k.AdminPower.Get(ctx, valOpBech32)
+	hasAdminPower := err == nil
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return stakingtypes.Validator{}, err
+	}
+
+	// no need to process anything, same values
+	if prevShares == newShares {
+		return val, fmt.Errorf("current admin power (%d) is the same as the new admin power (%d) for %s", prevShares, newShares, valOpBech32)
+	}
+
+	delegated, err := k.delegatedTokens(ctx, val)
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	selfTokens := hybridSelfTokens(params, newShares)
+
+	// a validator jailed by x/slashing stays jailed until it unjails itself, one removed by the admin is unjailed.
+	unjail := val.Jailed && !hasAdminPower && newShares > 0
+
+	var currentPower, newPower int64
+	if !val.Jailed {
+		currentPower = k.stakingKeeper.TokensToConsensusPower(ctx, val.Tokens)
+	}
+	if newShares > 0 && (!val.Jailed || unjail) {
+		newPower = k.stakingKeeper.TokensToConsensusPower(ctx, delegated.Add(selfTokens))
+	}
+
+	// Organizations may not exceed their maximum share of the total power through any of their validators, the change
+	// is then tracked in the power of the validator's organization.
+	if err := k.checkOrganizationPowerLimit(ctx, valOpBech32, currentPower, newPower); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	if err := k.addValidatorOrganizationPower(ctx, valOpBech32, newPower-currentPower); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	val, err = k.setHybridSelfTokens(ctx, val, selfTokens)
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	consAddr, err := val.GetConsAddr()
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	if newShares == 0 {
+		if err := k.AdminPower.Remove(ctx, valOpBech32); err != nil {
+			return stakingtypes.Validator{}, err
+		}
+
+		if !val.Jailed {
+			if err := k.stakingKeeper.Jail(ctx, consAddr); err != nil {
+				return stakingtypes.Validator{}, err
+			}
+			val.Jailed = true
+		}
+	} else {
+		if err := k.AdminPower.Set(ctx, valOpBech32, newShares); err != nil {
+			return stakingtypes.Validator{}, err
+		}
+
+		if unjail {
+			if err := k.stakingKeeper.Unjail(ctx, consAddr); err != nil {
+				return stakingtypes.Validator{}, err
+			}
+			val.Jailed = false
+		}
+	}
+
+	absPowerDiff := uint64(newPower - currentPower)
+	if newPower < currentPower {
+		absPowerDiff = uint64(currentPower - newPower)
+	}
+
+	k.Logger().Debug("POA setPOAPowerHybrid",
+		"valOpBech32", valOpBech32,
+		"New Admin Power", newShares,
+		"Delegated Tokens", delegated,
+		"New Consensus Power", newPower,
+		"Previous Power", currentPower,
+		"absPowerDiff", absPowerDiff,
+	)
+
+	return val, k.IncreaseAbsoluteChangedInBlockPower(ctx, absPowerDiff)
+}
+
+// setHybridSelfTokens sets the tokens of the POA self delegation of a validator, keeping the value of the delegations
+// of token holders. The difference is minted into or burned from the staking pool holding the validator tokens.
+func (k Keeper) setHybridSelfTokens(ctx context.Context, val stakingtypes.Validator, selfTokens sdkmath.Int) (stakingtypes.Validator, error) {
+	valAddr, err := k.GetValidatorAddressCodec().StringToBytes(val.OperatorAddress)
+	if err != nil {
+		return val, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	delAddr := sdk.AccAddress(valAddr)
+
+	del, found, err := k.selfDelegation(ctx, valAddr)
+	if err != nil {
+		return val, err
+	}
+
+	selfShares := sdkmath.LegacyZeroDec()
+	if found {
+		selfShares = del.Shares
+	}
+
+	prevTokens := val.Tokens
+	newSelfShares := sdkmath.LegacyZeroDec()
+
+	if val.DelegatorShares.Equal(selfShares) {
+		// only the POA self delegation is left, the exchange rate is reset.
+		val.Tokens = selfTokens
+		newSelfShares = sdkmath.LegacyNewDecFromInt(selfTokens)
+		val.DelegatorShares = newSelfShares
+	} else {
+		if selfTokens.IsPositive() {
+			newSelfShares, err = val.SharesFromTokens(selfTokens)
+			if err != nil {
+				return val, err
+			}
+		}
+
+		val.Tokens = val.Tokens.Sub(val.TokensFromShares(selfShares).TruncateInt()).Add(selfTokens)
+		val.DelegatorShares = val.DelegatorShares.Sub(selfShares).Add(newSelfShares)
+	}
+
+	if !val.Jailed {
+		// the power index key is derived from the tokens, so the previous entry is removed first.
+		prev := val
+		prev.Tokens = prevTokens
+		if err := k.stakingKeeper.DeleteValidatorByPowerIndex(ctx, prev); err != nil {
+			return val, err
+		}
+	}
+
+	if err := k.beforeSelfDelegationModified(ctx, delAddr, valAddr, found); err != nil {
+		return val, err
+	}
+
+	// the POA self delegation is set by the admin, it is not checked as a delegation of a token holder.
+	if err := k.ModifiedDelegations.Remove(ctx, collections.Join(delAddr, sdk.ValAddress(valAddr))); err != nil {
+		return val, err
+	}
+
+	if err := k.stakingKeeper.SetValidator(ctx, val); err != nil {
+		return val, err
+	}
+
+	if newSelfShares.IsPositive() {
+		if err := k.stakingKeeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddr.String(), val.OperatorAddress, newSelfShares)); err != nil {
+			return val, err
+		}
+
+		if err := k.stakingKeeper.Hooks().AfterDelegationModified(ctx, delAddr, valAddr); err != nil {
+			return val, err
+		}
+	} else if found {
+		if err := k.stakingKeeper.RemoveDelegation(ctx, del); err != nil {
+			return val, err
+		}
+	}
+
+	if !val.Jailed {
+		if err := k.stakingKeeper.SetValidatorByPowerIndex(ctx, val); err != nil {
+			return val, err
+		}
+	}
+
+	pool := stakingtypes.NotBondedPoolName
+	if val.IsBonded() {
+		pool = stakingtypes.BondedPoolName
+	}
+
+	return val, k.adjustPool(ctx, pool, val.Tokens.Sub(prevTokens))
+}
+
+// beforeSelfDelegationModified runs the staking hook for the self delegation being created or modified.
+func (k Keeper) beforeSelfDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, found bool) error {
+	if !found {
+		return k.stakingKeeper.Hooks().BeforeDelegationCreated(ctx, delAddr, valAddr)
+	}
+
+	err := k.stakingKeeper.Hooks().BeforeDelegationSharesModified(ctx, delAddr, valAddr)
+	if errors.Is(err, distrtypes.ErrEmptyDelegationDistInfo) {
+		// POA sets the self delegation without the hooks outside of hybrid mode, it has no rewards to withdraw yet.
+		return k.stakingKeeper.Hooks().BeforeDelegationCreated(ctx, delAddr, valAddr)
+	}
+
+	return err
+}
+
+// updateHybridMode applies a change of the hybrid mode params to the validators.
+// - enabling it re-indexes the validators and converts their POA power into admin assigned power
+// - disabling it, only allowed without delegations of token holders, sets the admin assigned power back as the POA power
+// - a new admin power weight rescales the POA self delegations
+func (k Keeper) updateHybridMode(ctx context.Context, prev, params poa.Params) error {
+	// the bonded tokens are not tracked in hybrid mode, they are reconciled when they are read again.
+	if prev.HybridMode != params.HybridMode {
+		if err := k.BondedTokens.Remove(ctx); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case !prev.HybridMode && params.HybridMode:
+		return k.enableHybridMode(ctx, params)
+	case prev.HybridMode && !params.HybridMode:
+		return k.disableHybridMode(ctx)
+	case params.HybridMode && !prev.AdminPowerWeight.Equal(params.AdminPowerWeight):
+		return k.rescaleAdminPowers(ctx, params)
+	default:
+		return nil
+	}
+}
+
+func (k Keeper) enableHybridMode(ctx context.Context, params poa.Params) error {
+	// the power index of validators updated in this block is cleaned up by the next begin block.
+	if pending, err := k.hasUpdatedValidators(ctx); err != nil {
+		return err
+	} else if pending {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "validator power was updated in this block, enable hybrid mode in a later block")
+	}
+
+	if err := k.rebuildPowerIndex(ctx); err != nil {
+		return err
+	}
+
+	vals, err := k.stakingKeeper.GetAllValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	bondedTokens := sdkmath.ZeroInt()
+	for _, val := range vals {
+		if val.IsBonded() {
+			bondedTokens = bondedTokens.Add(val.Tokens)
+		}
+
+		valAddr, err := k.GetValidatorAddressCodec().StringToBytes(val.OperatorAddress)
+		if err != nil {
+			return err
+		}
+
+		del, found, err := k.selfDelegation(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		if !found || !del.Shares.IsPositive() || val.Jailed {
+			continue
+		}
+
+		// POA sets the validator tokens equal to the shares of its single self delegation.
+		if err := k.AdminPower.Set(ctx, val.OperatorAddress, del.Shares.TruncateInt().Uint64()); err != nil {
+			return err
+		}
+	}
+
+	// POA only mints into the bonded pool, it is set to the bonded tokens before they are rescaled.
+	bondedPool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName)
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, bondedPool, bondDenom).Amount
+	if err := k.adjustPool(ctx, stakingtypes.BondedPoolName, bondedTokens.Sub(balance)); err != nil {
+		return err
+	}
+
+	return k.rescaleAdminPowers(ctx, params)
+}
+
+func (k Keeper) disableHybridMode(ctx context.Context) error {
+	dels, err := k.stakingKeeper.GetAllDelegations(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, del := range dels {
+		if isSelf, err := k.IsSenderValidator(ctx, del.DelegatorAddress, del.ValidatorAddress); err != nil {
+			return err
+		} else if !isSelf {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "hybrid mode can not be disabled while %s delegates to %s", del.DelegatorAddress, del.ValidatorAddress)
+		}
+	}
+
+	var powers []poa.ValidatorPower
+	if err := k.AdminPower.Walk(ctx, nil, func(valAddr string, power uint64) (bool, error) {
+		powers = append(powers, poa.ValidatorPower{ValidatorAddress: valAddr, Power: power})
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, vp := range powers {
+		if err := k.setAdminPowerTokens(ctx, vp.ValidatorAddress, sdkmath.NewIntFromUint64(vp.Power)); err != nil {
+			return err
+		}
+
+		if err := k.AdminPower.Remove(ctx, vp.ValidatorAddress); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rescaleAdminPowers sets the POA self delegation of every validator with an admin assigned power for the weight.
+func (k Keeper) rescaleAdminPowers(ctx context.Context, params poa.Params) error {
+	var powers []poa.ValidatorPower
+	if err := k.AdminPower.Walk(ctx, nil, func(valAddr string, power uint64) (bool, error) {
+		powers = append(powers, poa.ValidatorPower{ValidatorAddress: valAddr, Power: power})
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, vp := range powers {
+		if err := k.setAdminPowerTokens(ctx, vp.ValidatorAddress, hybridSelfTokens(params, vp.Power)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) setAdminPowerTokens(ctx context.Context, valOpBech32 string, selfTokens sdkmath.Int) error {
+	valAddr, err := sdk.ValAddressFromBech32(valOpBech32)
+	if err != nil {
+		return err
+	}
+
+	val, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	_, err = k.setHybridSelfTokens(ctx, val, selfTokens)
+	return err
+}
+
+func (k Keeper) hasUpdatedValidators(ctx context.Context) (bool, error) {
+	iter, err := k.UpdatedValidatorsCache.Iterate(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	return iter.Valid(), nil
+}
+
+// rebuildPowerIndex removes every entry of the x/staking validator power index and indexes each unjailed validator
+// once by its current tokens. POA sets a new entry on every power change, which leaves the previous one in place.
+func (k Keeper) rebuildPowerIndex(ctx context.Context) error {
+	iter, err := k.stakingKeeper.ValidatorsPowerStoreIterator(ctx)
+	if err != nil {
+		return err
+	}
+
+	var stale []stakingtypes.Validator
+	for ; iter.Valid(); iter.Next() {
+		// key is of format prefix (1 byte) || powerbytes (8 bytes) || addrLen (1byte) || addrBytes
+		key := iter.Key()
+		valAddr := stakingtypes.ParseValidatorPowerRankKey(key)
+
+		operator, err := k.GetValidatorAddressCodec().BytesToString(valAddr)
+		if err != nil {
+			return err
+		}
+
+		// the index key is derived from the tokens, so the stale entry is deleted with the power it was set with.
+		stale = append(stale, stakingtypes.Validator{
+			OperatorAddress: operator,
+			Tokens:          k.stakingKeeper.TokensFromConsensusPower(ctx, int64(binary.BigEndian.Uint64(key[1:9]))),
+		})
+	}
+
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for _, val := range stale {
+		if err := k.stakingKeeper.DeleteValidatorByPowerIndex(ctx, val); err != nil {
+			return err
+		}
+	}
+
+	vals, err := k.stakingKeeper.GetAllValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, val := range vals {
+		if val.Jailed {
+			continue
+		}
+
+		if err := k.stakingKeeper.SetValidatorByPowerIndex(ctx, val); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
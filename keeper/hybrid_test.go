@@ -0,0 +1,361 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+func (f *testFixture) enableHybridMode(t *testing.T, maxDelegatedPower uint64) {
+	t.Helper()
+
+	params := poa.DefaultParams()
+	params.HybridMode = true
+	params.BlockedMsgTypeUrls = poa.HybridBlockedMsgTypeURLs()
+	params.MaxDelegatedPower = maxDelegatedPower
+
+	_, err := f.msgServer.UpdateParams(f.ctx, &poa.MsgUpdateParams{Sender: f.authorityAddr, Params: params})
+	require.NoError(t, err)
+}
+
+func (f *testFixture) fundAccount(t *testing.T, addr sdk.AccAddress, amt int64) {
+	t.Helper()
+
+	if !f.accountkeeper.HasAccount(f.ctx, addr) {
+		f.accountkeeper.SetAccount(f.ctx, f.accountkeeper.NewAccountWithAddress(f.ctx, addr))
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(amt)))
+	require.NoError(t, f.bankkeeper.MintCoins(f.ctx, minttypes.ModuleName, coins))
+	require.NoError(t, f.bankkeeper.SendCoinsFromModuleToAccount(f.ctx, minttypes.ModuleName, addr, coins))
+}
+
+func TestValidateHybridStakingMsg(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 100)
+	require.NoError(err)
+
+	holder := f.addrs[1].String()
+	bond := sdk.NewCoin("stake", sdkmath.NewInt(1_000_000))
+	unauthorized := sdk.ValAddress(GenAcc().addr).String()
+
+	delegate := &stakingtypes.MsgDelegate{DelegatorAddress: holder, ValidatorAddress: vals[0].OperatorAddress, Amount: bond}
+	require.ErrorIs(f.k.ValidateHybridStakingMsg(f.ctx, delegate), poa.ErrStakingActionNotAllowed)
+
+	f.enableHybridMode(t, 1_500_000)
+
+	testCases := []struct {
+		name string
+		msg  sdk.Msg
+		err  error
+	}{
+		{
+			name: "delegate to authorized validator",
+			msg:  delegate,
+		},
+		{
+			name: "fail: delegate to unauthorized validator",
+			msg:  &stakingtypes.MsgDelegate{DelegatorAddress: holder, ValidatorAddress: unauthorized, Amount: bond},
+			err:  poa.ErrValidatorNotAuthorized,
+		},
+		{
+			name: "fail: delegate over the maximum delegated power",
+			msg: &stakingtypes.MsgDelegate{
+				DelegatorAddress: holder,
+				ValidatorAddress: vals[0].OperatorAddress,
+				Amount:           sdk.NewCoin("stake", sdkmath.NewInt(1_500_001)),
+			},
+			err: poa.ErrDelegationCapExceeded,
+		},
+		{
+			name: "fail: delegate by the validator operator",
+			msg: &stakingtypes.MsgDelegate{
+				DelegatorAddress: sdk.AccAddress(MustValAddressFromBech32(vals[0].OperatorAddress)).String(),
+				ValidatorAddress: vals[0].OperatorAddress,
+				Amount:           bond,
+			},
+			err: poa.ErrStakingActionNotAllowed,
+		},
+		{
+			name: "fail: redelegate to unauthorized validator",
+			msg: &stakingtypes.MsgBeginRedelegate{
+				DelegatorAddress:    holder,
+				ValidatorSrcAddress: vals[0].OperatorAddress,
+				ValidatorDstAddress: unauthorized,
+				Amount:              bond,
+			},
+			err: poa.ErrValidatorNotAuthorized,
+		},
+		{
+			name: "undelegate",
+			msg:  &stakingtypes.MsgUndelegate{DelegatorAddress: holder, ValidatorAddress: vals[0].OperatorAddress, Amount: bond},
+		},
+		{
+			name: "fail: staking create validator",
+			msg:  &stakingtypes.MsgCreateValidator{},
+			err:  poa.ErrStakingActionNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := f.k.ValidateHybridStakingMsg(f.ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(err, tc.err)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+}
+
+func TestMaxDelegatedPowerReduction(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 100)
+	require.NoError(err)
+
+	f.enableHybridMode(t, 1_500_000)
+
+	// 1.5 power is 150 tokens of 10^8 per power.
+	defaultPowerReduction := sdk.DefaultPowerReduction
+	sdk.DefaultPowerReduction = sdkmath.NewIntFromUint64(100_000_000)
+	t.Cleanup(func() { sdk.DefaultPowerReduction = defaultPowerReduction })
+
+	delegate := func(amt int64) *stakingtypes.MsgDelegate {
+		return &stakingtypes.MsgDelegate{
+			DelegatorAddress: f.addrs[1].String(),
+			ValidatorAddress: vals[0].OperatorAddress,
+			Amount:           sdk.NewCoin("stake", sdkmath.NewInt(amt)),
+		}
+	}
+
+	require.NoError(f.k.ValidateHybridStakingMsg(f.ctx, delegate(150_000_000)))
+	require.ErrorIs(f.k.ValidateHybridStakingMsg(f.ctx, delegate(150_000_001)), poa.ErrDelegationCapExceeded)
+}
+
+func TestHybridMode(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	stakingMsgServer := stakingkeeper.NewMsgServerImpl(f.stakingKeeper)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 100)
+	require.NoError(err)
+	valAddr := MustValAddressFromBech32(vals[0].OperatorAddress)
+
+	// the admin power is kept with an even weight, the set is unchanged.
+	f.enableHybridMode(t, 0)
+
+	updates, err := f.IncreaseBlock(1)
+	require.NoError(err)
+	require.Empty(updates)
+
+	adminPower, err := f.k.AdminPower.Get(f.ctx, vals[0].OperatorAddress)
+	require.NoError(err)
+	require.EqualValues(2_000_000, adminPower)
+
+	// a token holder delegation adds to the validator power.
+	holder := f.addrs[1]
+	f.fundAccount(t, holder, 1_000_000)
+
+	delegate := &stakingtypes.MsgDelegate{
+		DelegatorAddress: holder.String(),
+		ValidatorAddress: vals[0].OperatorAddress,
+		Amount:           sdk.NewCoin("stake", sdkmath.NewInt(1_000_000)),
+	}
+	require.NoError(f.k.ValidateHybridStakingMsg(f.ctx, delegate))
+	_, err = stakingMsgServer.Delegate(f.ctx, delegate)
+	require.NoError(err)
+
+	updates, err = f.IncreaseBlock(1)
+	require.NoError(err)
+	require.Len(updates, 1)
+	require.EqualValues(3, updates[0].Power)
+
+	// the admin power changes, the holder delegation keeps its value.
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+		Power:            4_000_000,
+		Unsafe:           true,
+	})
+	require.NoError(err)
+
+	val, err := f.stakingKeeper.GetValidator(f.ctx, valAddr)
+	require.NoError(err)
+
+	del, err := f.stakingKeeper.GetDelegation(f.ctx, holder, valAddr)
+	require.NoError(err)
+	require.EqualValues(1_000_000, val.TokensFromShares(del.Shares).TruncateInt().Int64())
+
+	updates, err = f.IncreaseBlock(1)
+	require.NoError(err)
+	require.Len(updates, 1)
+	require.EqualValues(5, updates[0].Power)
+
+	// hybrid mode can not be disabled while holders delegate.
+	params := poa.DefaultParams()
+	_, err = f.msgServer.UpdateParams(f.ctx, &poa.MsgUpdateParams{Sender: f.authorityAddr, Params: params})
+	require.Error(err)
+
+	// removing the validator jails it, the holder delegation is kept to unbond through x/staking.
+	_, err = f.msgServer.RemoveValidator(f.ctx, &poa.MsgRemoveValidator{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+	})
+	require.NoError(err)
+
+	val, err = f.stakingKeeper.GetValidator(f.ctx, valAddr)
+	require.NoError(err)
+	require.True(val.Jailed)
+	require.EqualValues(1_000_000, val.Tokens.Int64())
+
+	has, err := f.k.AdminPower.Has(f.ctx, vals[0].OperatorAddress)
+	require.NoError(err)
+	require.False(has)
+
+	updates, err = f.IncreaseBlock(1)
+	require.NoError(err)
+	require.Len(updates, 1)
+	require.EqualValues(0, updates[0].Power)
+
+	_, err = f.stakingKeeper.GetDelegation(f.ctx, holder, valAddr)
+	require.NoError(err)
+}
+
+func TestHybridModeDelegationHooks(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	// the staking msg server is called directly, as by a gov or group proposal execution which skips the ante handler.
+	stakingMsgServer := stakingkeeper.NewMsgServerImpl(f.stakingKeeper)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 100)
+	require.NoError(err)
+
+	f.enableHybridMode(t, 1_500_000)
+
+	holder := f.addrs[1]
+	f.fundAccount(t, holder, 3_000_000)
+
+	bond := sdk.NewCoin("stake", sdkmath.NewInt(1_000_000))
+	delegate := &stakingtypes.MsgDelegate{DelegatorAddress: holder.String(), ValidatorAddress: vals[0].OperatorAddress, Amount: bond}
+	_, err = stakingMsgServer.Delegate(f.ctx, delegate)
+	require.NoError(err)
+
+	// the second delegation takes the validator over the maximum delegated power.
+	_, err = stakingMsgServer.Delegate(f.ctx, delegate)
+	require.ErrorIs(err, poa.ErrDelegationCapExceeded)
+
+	// the POA self delegation is managed by the admin.
+	operator := sdk.AccAddress(MustValAddressFromBech32(vals[1].OperatorAddress))
+	f.fundAccount(t, operator, 1_000_000)
+	_, err = stakingMsgServer.Delegate(f.ctx, &stakingtypes.MsgDelegate{
+		DelegatorAddress: operator.String(),
+		ValidatorAddress: vals[1].OperatorAddress,
+		Amount:           bond,
+	})
+	require.ErrorIs(err, poa.ErrStakingActionNotAllowed)
+
+	for _, amt := range []int64{1_000_000, 2_000_000} {
+		_, err = stakingMsgServer.Undelegate(f.ctx, &stakingtypes.MsgUndelegate{
+			DelegatorAddress: operator.String(),
+			ValidatorAddress: vals[1].OperatorAddress,
+			Amount:           sdk.NewCoin("stake", sdkmath.NewInt(amt)),
+		})
+		require.ErrorIs(err, poa.ErrStakingActionNotAllowed)
+	}
+
+	// the admin power of a removed validator is unset, token holders can no longer delegate to it.
+	_, err = f.msgServer.RemoveValidator(f.ctx, &poa.MsgRemoveValidator{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+	})
+	require.NoError(err)
+
+	_, err = stakingMsgServer.Delegate(f.ctx, &stakingtypes.MsgDelegate{
+		DelegatorAddress: holder.String(),
+		ValidatorAddress: vals[0].OperatorAddress,
+		Amount:           sdk.NewCoin("stake", sdkmath.NewInt(100_000)),
+	})
+	require.ErrorIs(err, poa.ErrValidatorNotAuthorized)
+
+	// the holder can still undelegate from it.
+	_, err = stakingMsgServer.Undelegate(f.ctx, &stakingtypes.MsgUndelegate{
+		DelegatorAddress: holder.String(),
+		ValidatorAddress: vals[0].OperatorAddress,
+		Amount:           bond,
+	})
+	require.NoError(err)
+
+	// no delegation is left recorded between the hooks.
+	iter, err := f.k.ModifiedDelegations.Iterate(f.ctx, nil)
+	require.NoError(err)
+	defer iter.Close()
+	require.False(iter.Valid())
+}
+
+func TestHybridModeWeight(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	f.enableHybridMode(t, 0)
+
+	// a higher weight scales the self delegation of every validator.
+	params, err := f.k.GetParams(f.ctx)
+	require.NoError(err)
+	params.AdminPowerWeight = sdkmath.LegacyNewDecWithPrec(75, 2)
+
+	_, err = f.msgServer.UpdateParams(f.ctx, &poa.MsgUpdateParams{Sender: f.authorityAddr, Params: params})
+	require.NoError(err)
+
+	updates, err := f.IncreaseBlock(1)
+	require.NoError(err)
+	require.Len(updates, 3)
+	for _, update := range updates {
+		require.EqualValues(6, update.Power)
+	}
+
+	// without holder delegations, the admin power is set back as the POA power.
+	params.HybridMode = false
+	_, err = f.msgServer.UpdateParams(f.ctx, &poa.MsgUpdateParams{Sender: f.authorityAddr, Params: params})
+	require.NoError(err)
+
+	updates, err = f.IncreaseBlock(1)
+	require.NoError(err)
+	require.Len(updates, 3)
+	for _, update := range updates {
+		require.EqualValues(2, update.Power)
+	}
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 100)
+	require.NoError(err)
+
+	// the POA only mode keeps working on the rebuilt power index.
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+		Power:            3_000_000,
+		Unsafe:           true,
+	})
+	require.NoError(err)
+
+	updates, err = f.IncreaseBlock(1)
+	require.NoError(err)
+	require.Len(updates, 1)
+	require.EqualValues(3, updates[0].Power)
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"cosmossdk.io/collections"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+// RegisterInvariants registers the POA module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(poa.ModuleName, "bonded-tokens", BondedTokensInvariant(k))
+}
+
+// BondedTokensInvariant checks that the tracked bonded tokens equal the sum of all delegations. It holds trivially in
+// hybrid mode and before the bonded tokens are tracked.
+func BondedTokensInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		tracked, err := k.BondedTokens.Get(ctx)
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.FormatInvariant(poa.ModuleName, "bonded tokens", "not tracked yet"), false
+		} else if err != nil {
+			panic(err)
+		}
+
+		if hybrid, err := k.IsHybridMode(ctx); err != nil {
+			panic(err)
+		} else if hybrid {
+			return sdk.FormatInvariant(poa.ModuleName, "bonded tokens", "not tracked in hybrid mode"), false
+		}
+
+		total, err := k.sumDelegations(ctx)
+		if err != nil {
+			panic(err)
+		}
+
+		broken := !tracked.Equal(total)
+
+		return sdk.FormatInvariant(poa.ModuleName, "bonded tokens", fmt.Sprintf(
+			"\ttracked bonded tokens: %s\n\tsum of delegations: %s\n", tracked, total,
+		)), broken
+	}
+}
@@ -6,14 +6,14 @@ import (
 	"os"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"cosmossdk.io/collections"
-	addresscodec "cosmossdk.io/core/address"
+	"cosmossdk.io/core/address"
 	storetypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
@@ -22,7 +22,8 @@ import (
 )
 
 type Keeper struct {
-	cdc codec.BinaryCodec
+	cdc          codec.BinaryCodec
+	storeService storetypes.KVStoreService
 
 	stakingKeeper StakingKeeper
 	accountKeeper AccountKeeper // for testing
@@ -33,16 +34,45 @@ type Keeper struct {
 
 	// state management
 	Schema                 collections.Schema
-	PendingValidators      collections.Item[poa.Validators]
+	Params                 collections.Item[poa.Params]
+	PendingValidators      collections.Map[string, poa.Validator]
 	UpdatedValidatorsCache collections.KeySet[string]
 
 	CachedBlockPower            collections.Item[poa.PowerCache]
 	AbsoluteChangedInBlockPower collections.Item[poa.PowerCache]
 
+	Organizations          collections.Map[string, poa.Organization]
+	ValidatorOrganization  collections.Map[string, string]
+	OrganizationValidators collections.KeySet[collections.Pair[string, string]]
+	OrganizationPower      collections.Map[string, int64]
+
+	AuditLogSequence collections.Sequence
+	AuditLog         collections.Map[uint64, poa.AuditLogEntry]
+	Authorities      collections.Item[poa.Authorities]
+
+	AdminPower collections.Map[string, uint64]
+
+	AllowedAccounts collections.KeySet[string]
+	AllowedPrefixes collections.KeySet[string]
+
+	MaintenanceMode collections.Item[poa.MaintenanceMode]
+	Paused          collections.Item[bool]
+
+	FeeRecipients collections.Map[string, poa.FeeRecipient]
+
+	BondedTokens collections.Item[sdkmath.Int]
+
+	BannedValidators         collections.KeySet[string]
+	BannedConsensusAddresses collections.KeySet[string]
+	SlashedValidators        collections.KeySet[sdk.ValAddress]
+
+	ModifiedDelegations collections.Map[collections.Pair[sdk.AccAddress, sdk.ValAddress], stakingtypes.Delegation]
+
 	authority string
+	guardian  string
 }
 
-// NewKeeper creates a new poa Keeper instance
+// NewKeeper creates a new poa Keeper instance. The slashing keeper is nil on chains without x/slashing.
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService storetypes.KVStoreService,
@@ -63,18 +93,47 @@ func NewKeeper(
 
 	k := Keeper{
 		cdc:           cdc,
+		storeService:  storeService,
 		stakingKeeper: sk,
 		slashKeeper:   slk,
 		bankKeeper:    bk,
 		logger:        logger,
 
 		// Stores
-		PendingValidators:      collections.NewItem(sb, poa.PendingValidatorsKey, "pending", codec.CollValue[poa.Validators](cdc)),
+		Params:                 collections.NewItem(sb, poa.ParamsKey, "params", codec.CollValue[poa.Params](cdc)),
+		PendingValidators:      collections.NewMap(sb, poa.PendingValidatorsKey, "pending_validators", collections.StringKey, codec.CollValue[poa.Validator](cdc)),
 		UpdatedValidatorsCache: collections.NewKeySet(sb, poa.UpdatedValidatorsCacheKey, "updated_validators", collections.StringKey),
 
 		CachedBlockPower:            collections.NewItem(sb, poa.CachedPreviousBlockPowerKey, "cached_block", codec.CollValue[poa.PowerCache](cdc)),
 		AbsoluteChangedInBlockPower: collections.NewItem(sb, poa.AbsoluteChangedInBlockPowerKey, "absolute_changed_power", codec.CollValue[poa.PowerCache](cdc)),
 
+		Organizations:          collections.NewMap(sb, poa.OrganizationsKey, "organizations", collections.StringKey, codec.CollValue[poa.Organization](cdc)),
+		ValidatorOrganization:  collections.NewMap(sb, poa.ValidatorOrganizationKey, "validator_organization", collections.StringKey, collections.StringValue),
+		OrganizationValidators: collections.NewKeySet(sb, poa.OrganizationValidatorsKey, "organization_validators", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		OrganizationPower:      collections.NewMap(sb, poa.OrganizationPowerKey, "organization_power", collections.StringKey, collections.Int64Value),
+
+		AuditLogSequence: collections.NewSequence(sb, poa.AuditLogSequenceKey, "audit_log_sequence"),
+		AuditLog:         collections.NewMap(sb, poa.AuditLogKey, "audit_log", collections.Uint64Key, codec.CollValue[poa.AuditLogEntry](cdc)),
+		Authorities:      collections.NewItem(sb, poa.AuthoritiesKey, "authorities", codec.CollValue[poa.Authorities](cdc)),
+
+		AdminPower: collections.NewMap(sb, poa.AdminPowerKey, "admin_power", collections.StringKey, collections.Uint64Value),
+
+		AllowedAccounts: collections.NewKeySet(sb, poa.AllowedAccountsKey, "allowed_accounts", collections.StringKey),
+		AllowedPrefixes: collections.NewKeySet(sb, poa.AllowedPrefixesKey, "allowed_prefixes", collections.StringKey),
+
+		MaintenanceMode: collections.NewItem(sb, poa.MaintenanceModeKey, "maintenance_mode", codec.CollValue[poa.MaintenanceMode](cdc)),
+		Paused:          collections.NewItem(sb, poa.PausedKey, "paused", collections.BoolValue),
+
+		FeeRecipients: collections.NewMap(sb, poa.FeeRecipientsKey, "fee_recipients", collections.StringKey, codec.CollValue[poa.FeeRecipient](cdc)),
+
+		BondedTokens: collections.NewItem(sb, poa.BondedTokensKey, "bonded_tokens", sdk.IntValue),
+
+		BannedValidators:         collections.NewKeySet(sb, poa.BannedValidatorsKey, "banned_validators", collections.StringKey),
+		BannedConsensusAddresses: collections.NewKeySet(sb, poa.BannedConsensusAddressesKey, "banned_consensus_addresses", collections.StringKey),
+		SlashedValidators:        collections.NewKeySet(sb, poa.SlashedValidatorsKey, "slashed_validators", sdk.ValAddressKey),
+
+		ModifiedDelegations: collections.NewMap(sb, poa.ModifiedDelegationsKey, "modified_delegations", collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey), codec.CollValue[stakingtypes.Delegation](cdc)),
+
 		authority: adminAuthority,
 	}
 
@@ -93,11 +152,16 @@ func (k Keeper) GetStakingKeeper() StakingKeeper {
 	return k.stakingKeeper
 }
 
-func (k Keeper) GetValidatorAddressCodec() addresscodec.Codec {
+func (k Keeper) GetValidatorAddressCodec() address.Codec {
 	return k.stakingKeeper.ValidatorAddressCodec()
 }
 
-// GetSlashingKeeper returns the slashing keeper.
+// GetAccountAddressCodec returns the codec of the account addresses, with the bech32 prefix of the app config.
+func (k Keeper) GetAccountAddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+}
+
+// GetSlashingKeeper returns the slashing keeper, nil on chains without x/slashing.
 func (k Keeper) GetSlashingKeeper() SlashingKeeper {
 	return k.slashKeeper
 }
@@ -118,6 +182,22 @@ func (k *Keeper) SetTestAuthority(addr string) {
 	k.authority = addr
 }
 
+// SetGuardian sets the address allowed to pause the validator set operations, it must be called before the keeper is
+// passed to the message server.
+func (k *Keeper) SetGuardian(addr string) {
+	k.guardian = addr
+}
+
+// GetGuardian returns the address allowed to pause the validator set operations, empty if not set.
+func (k Keeper) GetGuardian() string {
+	return k.guardian
+}
+
+// IsGuardian checks if the given address is the guardian.
+func (k Keeper) IsGuardian(addr string) bool {
+	return k.guardian != "" && k.guardian == addr
+}
+
 func (k Keeper) GetAdmin(ctx context.Context) string {
 	return k.authority
 }
@@ -151,17 +231,18 @@ func (k Keeper) Logger() log.Logger {
 	return k.logger
 }
 
-// updateBondedPoolPower updates the bonded pool to the correct power for the network.
+// updateBondedPoolPower updates the bonded pool to the correct power for the network, using the tracked bonded tokens.
 func (k Keeper) UpdateBondedPoolPower(ctx context.Context) error {
-	newTotal := sdkmath.ZeroInt()
-
-	del, err := k.stakingKeeper.GetAllDelegations(ctx)
-	if err != nil {
+	// in hybrid mode the staking pools hold the delegated tokens, POA mints and burns the self delegation changes.
+	if hybrid, err := k.IsHybridMode(ctx); err != nil {
 		return err
+	} else if hybrid {
+		return nil
 	}
 
-	for _, d := range del {
-		newTotal = newTotal.Add(d.Shares.RoundInt())
+	newTotal, err := k.GetBondedTokens(ctx)
+	if err != nil {
+		return err
 	}
 
 	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
@@ -169,30 +250,40 @@ func (k Keeper) UpdateBondedPoolPower(ctx context.Context) error {
 		return err
 	}
 
+	// the power added is minted, and the power removed is burned, by the POA module account. Removed validators are
+	// slashed 100% by x/staking first, which already burns their bonded tokens.
 	prevBal := k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(stakingtypes.BondedPoolName), bondDenom).Amount
 
-	if newTotal.Equal(prevBal) {
+	return k.adjustPool(ctx, stakingtypes.BondedPoolName, newTotal.Sub(prevBal))
+}
+
+// adjustPool mints a positive amount into, or burns a negative amount from, the staking pool with the POA module
+// account. It does not depend on x/mint, chains with a fixed supply only give the POA module account the permissions.
+func (k Keeper) adjustPool(ctx context.Context, pool string, amount sdkmath.Int) error {
+	if amount.IsZero() {
 		return nil
 	}
 
-	if newTotal.GT(prevBal) {
-		diff := newTotal.Sub(prevBal)
-		coins := sdk.NewCoins(sdk.NewCoin(bondDenom, diff))
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
 
-		if err := k.bankKeeper.MintCoins(ctx, minttypes.ModuleName, coins); err != nil {
+	if amount.IsNegative() {
+		coins := sdk.NewCoins(sdk.NewCoin(bondDenom, amount.Neg()))
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, pool, poa.ModuleName, coins); err != nil {
 			return err
 		}
 
-		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, stakingtypes.BondedPoolName, coins); err != nil {
-			return err
-		}
+		return k.bankKeeper.BurnCoins(ctx, poa.ModuleName, coins)
 	}
 
-	// no need to check if it goes down. When it does, it's automatic from the staking module as tokens are moved from
-	// bonded -> ToNotBonded pool. As PoA, we do not want any tokens in the ToNotBonded pool, so when a validator is removed
-	// they are slashed 100% (since it is PoA this is fine) which decreases the BondedPool balance, and leave NotBonded at 0.
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, amount))
+	if err := k.bankKeeper.MintCoins(ctx, poa.ModuleName, coins); err != nil {
+		return err
+	}
 
-	return nil
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, poa.ModuleName, pool, coins)
 }
 
 // ResetCachedTotalPower resets the block power index to the current total power.
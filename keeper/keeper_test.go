@@ -24,6 +24,7 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
@@ -32,7 +33,6 @@ import (
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
-	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
@@ -50,6 +50,7 @@ var (
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		minttypes.ModuleName:           {authtypes.Minter},
 		govtypes.ModuleName:            {authtypes.Burner},
+		poa.ModuleName:                 {authtypes.Minter, authtypes.Burner},
 	}
 )
 
@@ -72,7 +73,7 @@ type testFixture struct {
 	authorityAddr string
 }
 
-func SetupTest(t *testing.T, baseValShares int64) *testFixture {
+func SetupTest(t testing.TB, baseValShares int64) *testFixture {
 	t.Helper()
 	f := new(testFixture)
 	require := require.New(t)
@@ -84,21 +85,22 @@ func SetupTest(t *testing.T, baseValShares int64) *testFixture {
 	f.authorityAddr = authorityAddr
 	f.addrs = simtestutil.CreateIncrementalAccounts(3)
 
-	key := storetypes.NewKVStoreKey(poa.ModuleName)
-	storeService := runtime.NewKVStoreService(key)
-	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
-
-	f.ctx = testCtx.Ctx
+	// every module has its own store, as in an app.
+	keys := storetypes.NewKVStoreKeys(
+		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey, slashingtypes.StoreKey, minttypes.StoreKey, poa.StoreKey,
+	)
+	f.ctx = testutil.DefaultContextWithKeys(keys, storetypes.NewTransientStoreKeys("transient_test"), nil).WithBlockTime(time.Now())
 
 	// Register SDK modules.
-	registerBaseSDKModules(f, encCfg, storeService, logger, require)
+	registerBaseSDKModules(f, encCfg, keys, logger, require)
 
 	// Setup POA Keeper.
-	f.k = keeper.NewKeeper(encCfg.Codec, storeService, f.stakingKeeper, f.slashingKeeper, f.bankkeeper, logger, authorityAddr)
+	f.k = keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(keys[poa.StoreKey]), f.stakingKeeper, f.slashingKeeper, f.bankkeeper, logger, authorityAddr)
 	f.k.SetTestAccountKeeper(f.accountkeeper)
 	f.msgServer = keeper.NewMsgServerImpl(f.k)
 	f.queryServer = keeper.NewQueryServerImpl(f.k)
 	f.appModule = poamodule.NewAppModule(encCfg.Codec, f.k)
+	f.stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(f.k.Hooks()))
 
 	// register interfaces
 	registerModuleInterfaces(encCfg)
@@ -108,19 +110,23 @@ func SetupTest(t *testing.T, baseValShares int64) *testFixture {
 	f.accountkeeper.SetModuleAccount(f.ctx, f.stakingKeeper.GetNotBondedPool(f.ctx))
 	f.accountkeeper.SetModuleAccount(f.ctx, f.stakingKeeper.GetBondedPool(f.ctx))
 	f.accountkeeper.SetModuleAccount(f.ctx, f.accountkeeper.GetModuleAccount(f.ctx, minttypes.ModuleName))
+	f.accountkeeper.SetModuleAccount(f.ctx, f.accountkeeper.GetModuleAccount(f.ctx, poa.ModuleName))
 	f.mintkeeper.InitGenesis(f.ctx, f.accountkeeper, minttypes.DefaultGenesisState())
 
 	// Set initial PoA state
 	f.InitPoAGenesis(t)
 
-	f.createBaseStakingValidators(t, baseValShares)
+	// a zero base leaves x/staking without validators, as before genesis.
+	if baseValShares > 0 {
+		f.createBaseStakingValidators(t, baseValShares)
+	}
 
 	os.Setenv("POA_BYPASS_ADMIN_CHECK_FOR_SIMULATION_TESTING_ONLY", "false")
 
 	return f
 }
 
-func (f *testFixture) InitPoAGenesis(t *testing.T) {
+func (f *testFixture) InitPoAGenesis(t testing.TB) {
 	t.Helper()
 
 	genState := poa.NewGenesisState()
@@ -130,13 +136,13 @@ func (f *testFixture) InitPoAGenesis(t *testing.T) {
 func registerBaseSDKModules(
 	f *testFixture,
 	encCfg moduletestutil.TestEncodingConfig,
-	storeService store.KVStoreService,
+	keys map[string]*storetypes.KVStoreKey,
 	logger log.Logger,
 	require *require.Assertions,
 ) {
 	// Auth Keeper.
 	f.accountkeeper = authkeeper.NewAccountKeeper(
-		encCfg.Codec, storeService,
+		encCfg.Codec, runtime.NewKVStoreService(keys[authtypes.StoreKey]),
 		authtypes.ProtoBaseAccount,
 		maccPerms,
 		authcodec.NewBech32Codec(sdk.Bech32MainPrefix), sdk.Bech32MainPrefix,
@@ -145,7 +151,7 @@ func registerBaseSDKModules(
 
 	// Bank Keeper.
 	f.bankkeeper = bankkeeper.NewBaseKeeper(
-		encCfg.Codec, storeService,
+		encCfg.Codec, runtime.NewKVStoreService(keys[banktypes.StoreKey]),
 		f.accountkeeper,
 		nil,
 		f.authorityAddr, logger,
@@ -153,7 +159,7 @@ func registerBaseSDKModules(
 
 	// Staking Keeper.
 	f.stakingKeeper = stakingkeeper.NewKeeper(
-		encCfg.Codec, storeService,
+		encCfg.Codec, runtime.NewKVStoreService(keys[stakingtypes.StoreKey]),
 		f.accountkeeper, f.bankkeeper, f.authorityAddr,
 		authcodec.NewBech32Codec(sdk.Bech32PrefixValAddr),
 		authcodec.NewBech32Codec(sdk.Bech32PrefixConsAddr),
@@ -163,7 +169,7 @@ func registerBaseSDKModules(
 
 	// Slashing Keeper.
 	f.slashingKeeper = slashingkeeper.NewKeeper(
-		encCfg.Codec, encCfg.Amino, storeService,
+		encCfg.Codec, encCfg.Amino, runtime.NewKVStoreService(keys[slashingtypes.StoreKey]),
 		f.stakingKeeper,
 		f.authorityAddr,
 	)
@@ -171,9 +177,9 @@ func registerBaseSDKModules(
 	require.NoError(err)
 
 	// Mint Keeper.
-	// This is required for `MintTokensToBondedPool` to remain happy.
+	// The POA module mints the power itself, the tests use x/mint to fund accounts.
 	f.mintkeeper = mintkeeper.NewKeeper(
-		encCfg.Codec, storeService,
+		encCfg.Codec, runtime.NewKVStoreService(keys[minttypes.StoreKey]),
 		f.stakingKeeper, f.accountkeeper, f.bankkeeper,
 		authtypes.FeeCollectorName, f.authorityAddr,
 	)
@@ -201,7 +207,7 @@ func GenAcc() valSetup {
 	}
 }
 
-func (f *testFixture) createBaseStakingValidators(t *testing.T, baseValShares int64) {
+func (f *testFixture) createBaseStakingValidators(t testing.TB, baseValShares int64) {
 	t.Helper()
 	require := require.New(t)
 	bondCoin := sdk.NewCoin("stake", sdkmath.NewInt(baseValShares))
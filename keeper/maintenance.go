@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+// GetMaintenanceMode returns the maintenance mode, disabled if it was never set.
+func (k Keeper) GetMaintenanceMode(ctx context.Context) (poa.MaintenanceMode, error) {
+	mode, err := k.MaintenanceMode.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return poa.MaintenanceMode{}, nil
+	}
+
+	return mode, err
+}
+
+// ValidateMaintenanceMsg returns an error if maintenance mode is active and the message is neither an allowed message
+// type, a POA message sent by the admin nor a pause of the guardian.
+func (k Keeper) ValidateMaintenanceMsg(ctx context.Context, msg sdk.Msg) error {
+	mode, err := k.GetMaintenanceMode(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !mode.IsActive(sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+		return nil
+	}
+
+	typeURL := sdk.MsgTypeURL(msg)
+	if mode.IsMsgAllowed(typeURL) {
+		return nil
+	}
+
+	if sender, ok := poa.AdminMsgSender(msg); ok && k.IsAdmin(ctx, sender) {
+		return nil
+	}
+
+	if pause, ok := msg.(*poa.MsgPause); ok && k.IsGuardian(pause.Guardian) {
+		return nil
+	}
+
+	return errorsmod.Wrapf(poa.ErrMaintenanceMode, "message %s is not allowed", typeURL)
+}
+
+// ExpireMaintenanceMode disables maintenance mode once its expiry height is reached.
+func (k Keeper) ExpireMaintenanceMode(ctx context.Context) error {
+	mode, err := k.GetMaintenanceMode(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !mode.Enabled || mode.IsActive(sdkCtx.BlockHeight()) {
+		return nil
+	}
+
+	if err := k.MaintenanceMode.Set(ctx, poa.MaintenanceMode{}); err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&poa.EventMaintenanceModeSet{})
+}
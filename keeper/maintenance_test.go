@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+func TestSetMaintenanceMode(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	height := f.ctx.BlockHeight()
+
+	testCases := []struct {
+		name string
+		msg  *poa.MsgSetMaintenanceMode
+		err  error
+	}{
+		{
+			name: "fail: not the admin",
+			msg:  &poa.MsgSetMaintenanceMode{Sender: f.addrs[1].String(), MaintenanceMode: poa.MaintenanceMode{Enabled: true}},
+			err:  poa.ErrNotAnAuthority,
+		},
+		{
+			name: "fail: expiry height of a disabled maintenance mode",
+			msg:  &poa.MsgSetMaintenanceMode{Sender: f.authorityAddr, MaintenanceMode: poa.MaintenanceMode{ExpiryHeight: height + 10}},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "fail: expiry height reached",
+			msg:  &poa.MsgSetMaintenanceMode{Sender: f.authorityAddr, MaintenanceMode: poa.MaintenanceMode{Enabled: true, ExpiryHeight: height}},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "fail: invalid allowed message type url",
+			msg: &poa.MsgSetMaintenanceMode{Sender: f.authorityAddr, MaintenanceMode: poa.MaintenanceMode{
+				Enabled:            true,
+				AllowedMsgTypeUrls: []string{"cosmos.bank.v1beta1.MsgSend"},
+			}},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "enable",
+			msg: &poa.MsgSetMaintenanceMode{Sender: f.authorityAddr, MaintenanceMode: poa.MaintenanceMode{
+				Enabled:            true,
+				ExpiryHeight:       height + 10,
+				AllowedMsgTypeUrls: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+			}},
+		},
+		{
+			name: "disable",
+			msg:  &poa.MsgSetMaintenanceMode{Sender: f.authorityAddr},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := f.msgServer.SetMaintenanceMode(f.ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(err, tc.err)
+				return
+			}
+			require.NoError(err)
+
+			res, err := f.queryServer.MaintenanceMode(f.ctx, &poa.QueryMaintenanceModeRequest{})
+			require.NoError(err)
+			require.True(tc.msg.MaintenanceMode.Equal(res.MaintenanceMode))
+			require.Equal(tc.msg.MaintenanceMode.Enabled, res.Active)
+		})
+	}
+}
+
+func TestValidateMaintenanceMsg(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	send := &banktypes.MsgSend{}
+	multiSend := &banktypes.MsgMultiSend{}
+	setPower := &poa.MsgSetPower{Sender: f.authorityAddr}
+
+	// maintenance mode is disabled by default.
+	require.NoError(f.k.ValidateMaintenanceMsg(f.ctx, multiSend))
+
+	_, err := f.msgServer.SetMaintenanceMode(f.ctx, &poa.MsgSetMaintenanceMode{
+		Sender: f.authorityAddr,
+		MaintenanceMode: poa.MaintenanceMode{
+			Enabled:            true,
+			ExpiryHeight:       f.ctx.BlockHeight() + 2,
+			AllowedMsgTypeUrls: []string{sdk.MsgTypeURL(send)},
+		},
+	})
+	require.NoError(err)
+
+	require.NoError(f.k.ValidateMaintenanceMsg(f.ctx, setPower))
+	require.NoError(f.k.ValidateMaintenanceMsg(f.ctx, send))
+	require.ErrorIs(f.k.ValidateMaintenanceMsg(f.ctx, multiSend), poa.ErrMaintenanceMode)
+	require.ErrorIs(f.k.ValidateMaintenanceMsg(f.ctx, &poa.MsgSetPower{Sender: f.addrs[1].String()}), poa.ErrMaintenanceMode)
+
+	// expires at the expiry height.
+	_, err = f.IncreaseBlock(2)
+	require.NoError(err)
+
+	require.NoError(f.k.ValidateMaintenanceMsg(f.ctx, multiSend))
+
+	mode, err := f.k.GetMaintenanceMode(f.ctx)
+	require.NoError(err)
+	require.Equal(poa.MaintenanceMode{}, mode)
+}
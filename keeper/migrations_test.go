@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/strangelove-ventures/poa"
+	"github.com/strangelove-ventures/poa/keeper"
+	v2 "github.com/strangelove-ventures/poa/migrations/v2"
+)
+
+// legacyParams encodes the params item of releases which still kept the admins in the store.
+func legacyParams(admins []string, allowValidatorSelfExit bool) []byte {
+	var bz []byte
+	for _, admin := range admins {
+		bz = protowire.AppendTag(bz, 1, protowire.BytesType)
+		bz = protowire.AppendString(bz, admin)
+	}
+
+	bz = protowire.AppendTag(bz, 2, protowire.VarintType)
+	return protowire.AppendVarint(bz, protowire.EncodeBool(allowValidatorSelfExit))
+}
+
+func TestMigrate1to2(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	poa.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	noSelfExit := poa.DefaultParams()
+	noSelfExit.AllowValidatorSelfExit = false
+
+	newPendingValidator := func(t *testing.T) poa.Validator {
+		t.Helper()
+
+		acc := GenAcc()
+		val, err := stakingtypes.NewValidator(sdk.ValAddress(acc.addr).String(), acc.valKey.PubKey(), stakingtypes.Description{Moniker: "pending"})
+		require.NoError(t, err)
+
+		return poa.ConvertStakingToPOA(val)
+	}
+
+	testCases := []struct {
+		name           string
+		legacyParams   []byte
+		pending        []poa.Validator
+		expectedParams poa.Params
+	}{
+		{
+			name:           "no v1 state",
+			expectedParams: poa.DefaultParams(),
+		},
+		{
+			name:           "pending validators are re-keyed",
+			pending:        []poa.Validator{newPendingValidator(t), newPendingValidator(t), newPendingValidator(t)},
+			expectedParams: poa.DefaultParams(),
+		},
+		{
+			name:           "legacy params keep the self exit setting",
+			legacyParams:   legacyParams([]string{authorityAddr}, false),
+			pending:        []poa.Validator{newPendingValidator(t)},
+			expectedParams: noSelfExit,
+		},
+		{
+			name:           "legacy params allowing self exit",
+			legacyParams:   legacyParams([]string{authorityAddr, "cosmos1hj5fveer5cjtn4wd6wstzugjfdxzl0xpxvjjvr"}, true),
+			expectedParams: poa.DefaultParams(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+
+			key := storetypes.NewKVStoreKey(poa.ModuleName)
+			storeService := runtime.NewKVStoreService(key)
+			ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+			// load the v1 state.
+			store := ctx.KVStore(key)
+			if tc.legacyParams != nil {
+				store.Set(poa.ParamsKey, tc.legacyParams)
+			}
+
+			if tc.pending != nil {
+				bz, err := encCfg.Codec.Marshal(&poa.Validators{Validators: tc.pending})
+				require.NoError(err)
+				store.Set(v2.PendingValidatorsKey, bz)
+			}
+
+			k := keeper.NewKeeper(encCfg.Codec, storeService, nil, nil, nil, log.NewNopLogger(), authorityAddr)
+			require.NoError(keeper.NewMigrator(k).Migrate1to2(ctx))
+
+			// assert the v2 state.
+			require.False(store.Has(v2.PendingValidatorsKey))
+
+			params, err := k.GetParams(ctx)
+			require.NoError(err)
+			require.Equal(tc.expectedParams, params)
+
+			pending, err := k.GetPendingValidators(ctx)
+			require.NoError(err)
+			require.Len(pending.Validators, len(tc.pending))
+
+			for _, val := range tc.pending {
+				isPending, err := k.IsValidatorPending(ctx, val.OperatorAddress)
+				require.NoError(err)
+				require.True(isPending)
+
+				migrated, err := k.GetPendingValidator(ctx, val.OperatorAddress)
+				require.NoError(err)
+				require.Equal(val.Description, migrated.Description)
+				require.True(val.ConsensusPubkey.Equal(migrated.ConsensusPubkey))
+			}
+		})
+	}
+}
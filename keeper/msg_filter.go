@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+// ValidateMsgAllowed returns an error if the message type is blocked in the params. In hybrid mode the x/staking
+// delegation messages are validated by ValidateHybridStakingMsg instead.
+func (k Keeper) ValidateMsgAllowed(ctx context.Context, msg sdk.Msg) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.HybridMode && isDelegationMsg(msg) {
+		return k.ValidateHybridStakingMsg(ctx, msg)
+	}
+
+	if typeURL := sdk.MsgTypeURL(msg); slices.Contains(params.BlockedMsgTypeUrls, typeURL) {
+		return errorsmod.Wrap(poa.ErrMsgTypeBlocked, typeURL)
+	}
+
+	return nil
+}
+
+// isDelegationMsg returns true for the x/staking messages token holders may use in hybrid mode.
+func isDelegationMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *stakingtypes.MsgDelegate,
+		*stakingtypes.MsgUndelegate,
+		*stakingtypes.MsgBeginRedelegate,
+		*stakingtypes.MsgCancelUnbondingDelegation:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+func TestValidateMsgAllowed(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 100)
+	require.NoError(err)
+
+	delegate := &stakingtypes.MsgDelegate{
+		DelegatorAddress: f.addrs[1].String(),
+		ValidatorAddress: vals[0].OperatorAddress,
+		Amount:           sdk.NewCoin("stake", sdkmath.NewInt(1_000_000)),
+	}
+
+	testCases := []struct {
+		name    string
+		params  func(p *poa.Params)
+		msg     sdk.Msg
+		blocked bool
+	}{
+		{
+			name:    "default params block staking",
+			msg:     delegate,
+			blocked: true,
+		},
+		{
+			name:    "default params block withdraw delegator rewards",
+			msg:     &distrtypes.MsgWithdrawDelegatorReward{},
+			blocked: true,
+		},
+		{
+			name: "default params allow bank send",
+			msg:  &banktypes.MsgSend{},
+		},
+		{
+			name: "unblocked staking message",
+			params: func(p *poa.Params) {
+				p.BlockedMsgTypeUrls = []string{sdk.MsgTypeURL(&stakingtypes.MsgCreateValidator{})}
+			},
+			msg: &stakingtypes.MsgCancelUnbondingDelegation{},
+		},
+		{
+			name: "blocked bank message",
+			params: func(p *poa.Params) {
+				p.BlockedMsgTypeUrls = append(p.BlockedMsgTypeUrls, sdk.MsgTypeURL(&distrtypes.MsgSetWithdrawAddress{}))
+			},
+			msg:     &distrtypes.MsgSetWithdrawAddress{},
+			blocked: true,
+		},
+		{
+			name: "hybrid mode validates delegations",
+			params: func(p *poa.Params) {
+				p.HybridMode = true
+				p.BlockedMsgTypeUrls = poa.HybridBlockedMsgTypeURLs()
+			},
+			msg: delegate,
+		},
+		{
+			name: "hybrid mode allows withdraw delegator rewards",
+			params: func(p *poa.Params) {
+				p.HybridMode = true
+				p.BlockedMsgTypeUrls = poa.HybridBlockedMsgTypeURLs()
+			},
+			msg: &distrtypes.MsgWithdrawDelegatorReward{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := poa.DefaultParams()
+			if tc.params != nil {
+				tc.params(&params)
+			}
+			require.NoError(f.k.SetParams(f.ctx, params))
+
+			// the admin power of the validators is only converted when hybrid mode is enabled with UpdateParams.
+			if params.HybridMode {
+				require.NoError(f.k.AdminPower.Set(f.ctx, vals[0].OperatorAddress, 2_000_000))
+			}
+
+			err := f.k.ValidateMsgAllowed(f.ctx, tc.msg)
+			if tc.blocked {
+				require.ErrorIs(err, poa.ErrMsgTypeBlocked)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -27,6 +28,10 @@ func NewMsgServerImpl(keeper Keeper) poa.MsgServer {
 }
 
 func (ms msgServer) SetPower(ctx context.Context, msg *poa.MsgSetPower) (*poa.MsgSetPowerResponse, error) {
+	if err := ms.k.CheckNotPaused(ctx); err != nil {
+		return nil, err
+	}
+
 	if !ms.k.IsAdmin(ctx, msg.Sender) {
 		return nil, errorsmod.Wrapf(poa.ErrNotAnAuthority, "sender %s is not an authority. allowed: %+v", msg.Sender, ms.k.GetAdmin(ctx))
 	}
@@ -35,38 +40,78 @@ func (ms msgServer) SetPower(ctx context.Context, msg *poa.MsgSetPower) (*poa.Ms
 		return nil, err
 	}
 
-	// Accept a validator into the active set if they are pending approval.
-	if isPending, err := ms.k.IsValidatorPending(ctx, msg.ValidatorAddress); err != nil {
+	if err := ms.k.checkNotBanned(ctx, msg.ValidatorAddress, nil); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	isPending, err := ms.k.IsValidatorPending(ctx, msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
 		return nil, err
-	} else if isPending {
-		if err := ms.k.AcceptNewValidator(ctx, msg.ValidatorAddress, msg.Power); err != nil {
+	}
+
+	// In equal power mode the admin only accepts or removes validators, the power is set by the rebalance.
+	shares := msg.Power
+	if params.EqualPower.Enabled {
+		if shares, err = ms.k.equalPowerShares(ctx, params, msg.Power, isPending); err != nil {
+			return nil, err
+		}
+	}
+
+	// Accept a validator into the active set if they are pending approval.
+	if isPending {
+		if err := ms.k.AcceptNewValidator(ctx, msg.ValidatorAddress, shares); err != nil {
 			return nil, err
 		}
+
+		if err := sdkCtx.EventManager().EmitTypedEvent(&poa.EventValidatorAccepted{
+			Actor:            msg.Sender,
+			ValidatorAddress: msg.ValidatorAddress,
+			Shares:           shares,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	valAddr, err := ms.k.GetValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	oldPower, err := ms.k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+	if err != nil {
+		return nil, err
 	}
 
 	// Sets the new POA power to the validator.
-	if _, err := ms.k.SetPOAPower(ctx, msg.ValidatorAddress, msg.Power); err != nil {
+	val, err := ms.k.SetPOAPower(ctx, msg.ValidatorAddress, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	cachedPower, err := ms.k.GetCachedBlockPower(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totalChanged, err := ms.k.GetAbsoluteChangedInBlockPower(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	// Check that the total power change of the block is not >=30% of the total power of the previous block.
 	// Transactions tagged `unsafe` will not be checked.
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	if !msg.Unsafe && sdkCtx.BlockHeight() > 1 {
-		cachedPower, err := ms.k.GetCachedBlockPower(ctx)
-		if err != nil {
-			return nil, err
-		}
-
 		if cachedPower == 0 {
 			return nil, errorsmod.Wrapf(poa.ErrUnsafePower, "cached power is 0 for block %d", sdkCtx.BlockHeight()-1)
 		}
 
-		totalChanged, err := ms.k.GetAbsoluteChangedInBlockPower(ctx)
-		if err != nil {
-			return nil, err
-		}
-
 		percent := (totalChanged * 100) / cachedPower
 
 		ms.k.Logger().Debug("POA SetPower",
@@ -75,17 +120,39 @@ func (ms msgServer) SetPower(ctx context.Context, msg *poa.MsgSetPower) (*poa.Ms
 			"percent", fmt.Sprintf("%d%%", percent),
 		)
 
-		if percent >= 30 {
+		if percent >= maxBlockPowerChangePercent {
 			return nil, poa.ErrUnsafePower
 		}
 	}
 
+	if err := sdkCtx.EventManager().EmitTypedEvent(&poa.EventPowerSet{
+		Actor:               msg.Sender,
+		ValidatorAddress:    msg.ValidatorAddress,
+		OldPower:            oldPower,
+		NewPower:            ms.k.stakingKeeper.TokensToConsensusPower(ctx, val.Tokens),
+		Shares:              shares,
+		Unsafe:              msg.Unsafe,
+		ChangedInBlockPower: totalChanged,
+		CachedBlockPower:    cachedPower,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.AppendAuditLog(ctx, poa.AuditActionSetPower, msg.Sender, msg.ValidatorAddress, msg); err != nil {
+		return nil, err
+	}
+
 	return &poa.MsgSetPowerResponse{}, ms.k.UpdateBondedPoolPower(ctx)
 }
 
 func (ms msgServer) RemoveValidator(ctx context.Context, msg *poa.MsgRemoveValidator) (*poa.MsgRemoveValidatorResponse, error) {
+	if err := ms.k.CheckNotPaused(ctx); err != nil {
+		return nil, err
+	}
+
 	// Sender is not an admin. Check if the sender is the validator and that validator exist.
-	if !ms.k.IsAdmin(ctx, msg.Sender) {
+	isAdmin := ms.k.IsAdmin(ctx, msg.Sender)
+	if !isAdmin {
 		// check if the sender is the validator being removed.
 		hasPermission, err := ms.k.IsSenderValidator(ctx, msg.Sender, msg.ValidatorAddress)
 		if err != nil {
@@ -95,6 +162,15 @@ func (ms msgServer) RemoveValidator(ctx context.Context, msg *poa.MsgRemoveValid
 		if !hasPermission {
 			return nil, poa.ErrNotAnAuthority
 		}
+
+		params, err := ms.k.GetParams(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if !params.AllowValidatorSelfExit {
+			return nil, poa.ErrValidatorSelfExitNotAllowed
+		}
 	}
 
 	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
@@ -112,6 +188,11 @@ func (ms msgServer) RemoveValidator(ctx context.Context, msg *poa.MsgRemoveValid
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "validator %s is not bonded", msg.ValidatorAddress)
 	}
 
+	oldPower, err := ms.k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
 	// Remove the validator from the active set with 0 consensus power.
 	val, err = ms.k.SetPOAPower(ctx, msg.ValidatorAddress, 0)
 	if err != nil {
@@ -123,6 +204,19 @@ func (ms msgServer) RemoveValidator(ctx context.Context, msg *poa.MsgRemoveValid
 		return nil, err
 	}
 
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&poa.EventValidatorRemoved{
+		Actor:            msg.Sender,
+		ValidatorAddress: msg.ValidatorAddress,
+		OldPower:         oldPower,
+		SelfRemoval:      !isAdmin,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.AppendAuditLog(ctx, poa.AuditActionRemoveValidator, msg.Sender, msg.ValidatorAddress, msg); err != nil {
+		return nil, err
+	}
+
 	return &poa.MsgRemoveValidatorResponse{}, ms.k.UpdateBondedPoolPower(ctx)
 }
 
@@ -131,15 +225,35 @@ func (ms msgServer) RemovePending(ctx context.Context, msg *poa.MsgRemovePending
 		return nil, errorsmod.Wrapf(poa.ErrNotAnAuthority, "sender %s is not an authority", msg.Sender)
 	}
 
-	return &poa.MsgRemovePendingResponse{}, ms.k.RemovePendingValidator(ctx, msg.ValidatorAddress)
+	if err := ms.k.RemoveValidatorOrganization(ctx, msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.RemovePendingValidator(ctx, msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.AppendAuditLog(ctx, poa.AuditActionRemovePending, msg.Sender, msg.ValidatorAddress, msg); err != nil {
+		return nil, err
+	}
+
+	return &poa.MsgRemovePendingResponse{}, sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&poa.EventPendingRemoved{
+		Actor:            msg.Sender,
+		ValidatorAddress: msg.ValidatorAddress,
+	})
 }
 
 // CreateValidator is from the x/staking module.
 // POA changes:
 // - MinSelfDelegation is force set to 1.
+// - Commission rates must be within the commission limits of the params.
 // - Create hook logic removed (this is done after acceptance).
 // - Valiadtor is added to the pending queue (AddPendingValidator).
 func (ms msgServer) CreateValidator(ctx context.Context, msg *poa.MsgCreateValidator) (*poa.MsgCreateValidatorResponse, error) {
+	if err := ms.k.CheckNotPaused(ctx); err != nil {
+		return nil, err
+	}
+
 	valAddr, err := ms.k.GetValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
@@ -158,6 +272,11 @@ func (ms msgServer) CreateValidator(ctx context.Context, msg *poa.MsgCreateValid
 		return nil, errorsmod.Wrapf(stakingtypes.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", minCommRate)
 	}
 
+	// enforced here as well as in the ante handler, for the messages executed by authz or ICA.
+	if err := ms.k.ValidateCommissionMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	// check to see if the pubkey or sender has been registered before
 	if _, err := ms.k.stakingKeeper.GetValidator(ctx, valAddr); err == nil {
 		return nil, stakingtypes.ErrValidatorOwnerExists
@@ -172,10 +291,21 @@ func (ms msgServer) CreateValidator(ctx context.Context, msg *poa.MsgCreateValid
 		return nil, stakingtypes.ErrValidatorPubKeyExists
 	}
 
+	// a validator removed for double signing can not be created again, by the same or a new operator.
+	if err := ms.k.checkNotBanned(ctx, msg.ValidatorAddress, sdk.GetConsAddress(pk)); err != nil {
+		return nil, err
+	}
+
 	if _, err := msg.Description.EnsureLength(); err != nil {
 		return nil, err
 	}
 
+	if msg.OrganizationId != "" {
+		if _, err := ms.k.GetOrganization(ctx, msg.OrganizationId); err != nil {
+			return nil, err
+		}
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	cp := sdkCtx.ConsensusParams()
 	if cp.Validator != nil {
@@ -224,11 +354,30 @@ func (ms msgServer) CreateValidator(ctx context.Context, msg *poa.MsgCreateValid
 		return nil, err
 	}
 
+	if msg.OrganizationId != "" {
+		if err := ms.k.SetValidatorOrganization(ctx, msg.ValidatorAddress, msg.OrganizationId); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&poa.EventValidatorPending{
+		Actor:            sdk.AccAddress(valAddr).String(),
+		ValidatorAddress: msg.ValidatorAddress,
+		Moniker:          msg.Description.Moniker,
+		OrganizationId:   msg.OrganizationId,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &poa.MsgCreateValidatorResponse{}, ms.k.UpdateBondedPoolPower(ctx)
 }
 
 // UpdateStakingParams wraps the x/staking module's UpdateStakingParams method so that only POA admins can invoke it.
 func (ms msgServer) UpdateStakingParams(ctx context.Context, msg *poa.MsgUpdateStakingParams) (*poa.MsgUpdateStakingParamsResponse, error) {
+	if err := ms.k.CheckNotPaused(ctx); err != nil {
+		return nil, err
+	}
+
 	if ok := ms.k.IsAdmin(ctx, msg.Sender); !ok {
 		return nil, poa.ErrNotAnAuthority
 	}
@@ -280,5 +429,288 @@ func (ms msgServer) UpdateStakingParams(ctx context.Context, msg *poa.MsgUpdateS
 		return nil, err
 	}
 
-	return &poa.MsgUpdateStakingParamsResponse{}, ms.k.stakingKeeper.SetParams(ctx, stakingParams)
+	if err := ms.k.validateVirtualPowerBondDenom(ctx, stakingParams.BondDenom); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.stakingKeeper.SetParams(ctx, stakingParams); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.AppendAuditLog(ctx, poa.AuditActionUpdateStakingParams, msg.Sender, "", msg); err != nil {
+		return nil, err
+	}
+
+	return &poa.MsgUpdateStakingParamsResponse{}, sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&poa.EventStakingParamsUpdated{
+		Actor: msg.Sender,
+		OldParams: poa.StakingParams{
+			UnbondingTime:     prevStakingParams.UnbondingTime,
+			MaxValidators:     prevStakingParams.MaxValidators,
+			MaxEntries:        prevStakingParams.MaxEntries,
+			HistoricalEntries: prevStakingParams.HistoricalEntries,
+			BondDenom:         prevStakingParams.BondDenom,
+			MinCommissionRate: prevStakingParams.MinCommissionRate,
+		},
+		NewParams: msg.Params,
+	})
+}
+
+// UpdateSlashingParams wraps the x/slashing module's UpdateParams method so that only POA admins can invoke it.
+func (ms msgServer) UpdateSlashingParams(ctx context.Context, msg *poa.MsgUpdateSlashingParams) (*poa.MsgUpdateSlashingParamsResponse, error) {
+	if err := ms.k.CheckNotPaused(ctx); err != nil {
+		return nil, err
+	}
+
+	if ok := ms.k.IsAdmin(ctx, msg.Sender); !ok {
+		return nil, poa.ErrNotAnAuthority
+	}
+
+	if ms.k.slashKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "updating the slashing params requires x/slashing")
+	}
+
+	prevSlashingParams, err := ms.k.slashKeeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	slashingParams := slashingtypes.Params{
+		SignedBlocksWindow:      msg.Params.SignedBlocksWindow,
+		MinSignedPerWindow:      msg.Params.MinSignedPerWindow,
+		DowntimeJailDuration:    msg.Params.DowntimeJailDuration,
+		SlashFractionDoubleSign: msg.Params.SlashFractionDoubleSign,
+		SlashFractionDowntime:   msg.Params.SlashFractionDowntime,
+	}
+
+	if err := slashingParams.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.slashKeeper.SetParams(ctx, slashingParams); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.AppendAuditLog(ctx, poa.AuditActionUpdateSlashingParams, msg.Sender, "", msg); err != nil {
+		return nil, err
+	}
+
+	return &poa.MsgUpdateSlashingParamsResponse{}, sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&poa.EventSlashingParamsUpdated{
+		Actor: msg.Sender,
+		OldParams: poa.SlashingParams{
+			SignedBlocksWindow:      prevSlashingParams.SignedBlocksWindow,
+			MinSignedPerWindow:      prevSlashingParams.MinSignedPerWindow,
+			DowntimeJailDuration:    prevSlashingParams.DowntimeJailDuration,
+			SlashFractionDoubleSign: prevSlashingParams.SlashFractionDoubleSign,
+			SlashFractionDowntime:   prevSlashingParams.SlashFractionDowntime,
+		},
+		NewParams: msg.Params,
+	})
+}
+
+// UpdateParams updates the POA module parameters.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *poa.MsgUpdateParams) (*poa.MsgUpdateParamsResponse, error) {
+	if ok := ms.k.IsAdmin(ctx, msg.Sender); !ok {
+		return nil, poa.ErrNotAnAuthority
+	}
+
+	prevParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.checkPowerModesNotPaused(ctx, prevParams, msg.Params); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.validateEqualPower(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.validateDowntimePolicy(msg.Params); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.updateHybridMode(ctx, prevParams, msg.Params); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.updateVirtualPower(ctx, prevParams, msg.Params); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.AppendAuditLog(ctx, poa.AuditActionUpdateParams, msg.Sender, "", msg); err != nil {
+		return nil, err
+	}
+
+	return &poa.MsgUpdateParamsResponse{}, sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&poa.EventParamsUpdated{
+		Actor:     msg.Sender,
+		OldParams: prevParams,
+		NewParams: msg.Params,
+	})
+}
+
+// RegisterOrganization registers a new organization of validators or updates an existing one.
+func (ms msgServer) RegisterOrganization(ctx context.Context, msg *poa.MsgRegisterOrganization) (*poa.MsgRegisterOrganizationResponse, error) {
+	if ok := ms.k.IsAdmin(ctx, msg.Sender); !ok {
+		return nil, poa.ErrNotAnAuthority
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	// a lowered share must still hold for the current validators of the organization.
+	if err := ms.k.CheckOrganizationShare(ctx, msg.Organization); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.SetOrganization(ctx, msg.Organization); err != nil {
+		return nil, err
+	}
+
+	return &poa.MsgRegisterOrganizationResponse{}, ms.k.AppendAuditLog(ctx, poa.AuditActionRegisterOrganization, msg.Sender, "", msg)
+}
+
+// SetValidatorOrganization assigns an active or pending validator to an organization.
+func (ms msgServer) SetValidatorOrganization(ctx context.Context, msg *poa.MsgSetValidatorOrganization) (*poa.MsgSetValidatorOrganizationResponse, error) {
+	if ok := ms.k.IsAdmin(ctx, msg.Sender); !ok {
+		return nil, poa.ErrNotAnAuthority
+	}
+
+	if err := msg.Validate(ms.k.GetValidatorAddressCodec()); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := ms.k.GetValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if _, err := ms.k.stakingKeeper.GetValidator(ctx, valAddr); err != nil {
+		isPending, err := ms.k.IsValidatorPending(ctx, msg.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isPending {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "validator %s does not exist", msg.ValidatorAddress)
+		}
+	}
+
+	if err := ms.k.SetValidatorOrganization(ctx, msg.ValidatorAddress, msg.OrganizationId); err != nil {
+		return nil, err
+	}
+
+	return &poa.MsgSetValidatorOrganizationResponse{}, ms.k.AppendAuditLog(ctx, poa.AuditActionSetValidatorOrganization, msg.Sender, msg.ValidatorAddress, msg)
+}
+
+// UpdateAccountAllowlist adds and removes accounts and address prefixes of the account allowlist.
+func (ms msgServer) UpdateAccountAllowlist(ctx context.Context, msg *poa.MsgUpdateAccountAllowlist) (*poa.MsgUpdateAccountAllowlistResponse, error) {
+	if ok := ms.k.IsAdmin(ctx, msg.Sender); !ok {
+		return nil, poa.ErrNotAnAuthority
+	}
+
+	if err := msg.Validate(ms.k.GetAccountAddressCodec()); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.UpdateAccountAllowlist(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &poa.MsgUpdateAccountAllowlistResponse{}, ms.k.AppendAuditLog(ctx, poa.AuditActionUpdateAccountAllowlist, msg.Sender, "", msg)
+}
+
+// SetMaintenanceMode enables or disables maintenance mode.
+func (ms msgServer) SetMaintenanceMode(ctx context.Context, msg *poa.MsgSetMaintenanceMode) (*poa.MsgSetMaintenanceModeResponse, error) {
+	if ok := ms.k.IsAdmin(ctx, msg.Sender); !ok {
+		return nil, poa.ErrNotAnAuthority
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if mode := msg.MaintenanceMode; mode.ExpiryHeight != 0 && mode.ExpiryHeight <= sdkCtx.BlockHeight() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "expiry height %d must be after the current height %d", mode.ExpiryHeight, sdkCtx.BlockHeight())
+	}
+
+	if err := ms.k.MaintenanceMode.Set(ctx, msg.MaintenanceMode); err != nil {
+		return nil, err
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&poa.EventMaintenanceModeSet{
+		Actor:           msg.Sender,
+		MaintenanceMode: msg.MaintenanceMode,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &poa.MsgSetMaintenanceModeResponse{}, ms.k.AppendAuditLog(ctx, poa.AuditActionSetMaintenanceMode, msg.Sender, "", msg)
+}
+
+// Pause stops the validator set operations until the admin and the guardian unpause them.
+func (ms msgServer) Pause(ctx context.Context, msg *poa.MsgPause) (*poa.MsgPauseResponse, error) {
+	if !ms.k.IsGuardian(msg.Guardian) {
+		return nil, poa.ErrNotGuardian
+	}
+
+	if err := ms.k.CheckNotPaused(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.Paused.Set(ctx, true); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&poa.EventPauseSet{
+		Actor:  msg.Guardian,
+		Paused: true,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &poa.MsgPauseResponse{}, ms.k.AppendAuditLog(ctx, poa.AuditActionPause, msg.Guardian, "", msg)
+}
+
+// Unpause resumes the validator set operations. The message is signed by both the admin and the guardian.
+func (ms msgServer) Unpause(ctx context.Context, msg *poa.MsgUnpause) (*poa.MsgUnpauseResponse, error) {
+	if ok := ms.k.IsAdmin(ctx, msg.Authority); !ok {
+		return nil, poa.ErrNotAnAuthority
+	}
+
+	if !ms.k.IsGuardian(msg.Guardian) {
+		return nil, poa.ErrNotGuardian
+	}
+
+	paused, err := ms.k.IsPaused(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !paused {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "validator set operations are not paused")
+	}
+
+	if err := ms.k.Paused.Set(ctx, false); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&poa.EventPauseSet{
+		Actor:  msg.Authority,
+		Paused: false,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &poa.MsgUnpauseResponse{}, ms.k.AppendAuditLog(ctx, poa.AuditActionUnpause, msg.Authority, "", msg)
 }
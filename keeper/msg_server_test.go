@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	sdkmath "cosmossdk.io/math"
@@ -61,6 +64,162 @@ func TestUpdateStakingParams(t *testing.T) {
 	}
 }
 
+func TestUpdateSlashingParams(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	zeroWindow := poa.DefaultSlashingParams()
+	zeroWindow.SignedBlocksWindow = 0
+
+	fullDowntimeSlash := poa.DefaultSlashingParams()
+	fullDowntimeSlash.SlashFractionDowntime = sdkmath.LegacyNewDec(2)
+
+	updated := poa.DefaultSlashingParams()
+	updated.SignedBlocksWindow = 200
+	updated.MinSignedPerWindow = sdkmath.LegacyNewDecWithPrec(1, 1)
+	updated.SlashFractionDoubleSign = sdkmath.LegacyZeroDec()
+
+	testCases := []struct {
+		name         string
+		request      *poa.MsgUpdateSlashingParams
+		expectErrMsg string
+	}{
+		{
+			name: "set invalid authority (not an address)",
+			request: &poa.MsgUpdateSlashingParams{
+				Sender: "foo",
+			},
+			expectErrMsg: "not an authority",
+		},
+		{
+			name: "set invalid authority (not defined authority)",
+			request: &poa.MsgUpdateSlashingParams{
+				Sender: f.addrs[1].String(),
+				Params: poa.DefaultSlashingParams(),
+			},
+			expectErrMsg: "not an authority",
+		},
+		{
+			name: "fail: zero signed blocks window",
+			request: &poa.MsgUpdateSlashingParams{
+				Sender: f.authorityAddr,
+				Params: zeroWindow,
+			},
+			expectErrMsg: "signed blocks window must be positive",
+		},
+		{
+			name: "fail: downtime slash fraction above 1",
+			request: &poa.MsgUpdateSlashingParams{
+				Sender: f.authorityAddr,
+				Params: fullDowntimeSlash,
+			},
+			expectErrMsg: "downtime slash fraction too large",
+		},
+		{
+			name: "set valid params",
+			request: &poa.MsgUpdateSlashingParams{
+				Sender: f.authorityAddr,
+				Params: updated,
+			},
+			expectErrMsg: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f.ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+
+			_, err := f.msgServer.UpdateSlashingParams(f.ctx, tc.request)
+			if tc.expectErrMsg != "" {
+				require.Error(err)
+				require.ErrorContains(err, tc.expectErrMsg)
+				return
+			}
+			require.NoError(err)
+
+			params, err := f.k.GetSlashingKeeper().GetParams(f.ctx)
+			require.NoError(err)
+			require.EqualValues(200, params.SignedBlocksWindow)
+			require.True(params.MinSignedPerWindow.Equal(updated.MinSignedPerWindow))
+			require.True(params.SlashFractionDoubleSign.IsZero())
+
+			event := requireTypedEvent[*poa.EventSlashingParamsUpdated](t, f.ctx)
+			require.Equal(f.authorityAddr, event.Actor)
+			require.True(event.OldParams.Equal(poa.DefaultSlashingParams()))
+			require.True(event.NewParams.Equal(updated))
+		})
+	}
+}
+
+func TestUpdateParams(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	noSelfExit := poa.DefaultParams()
+	noSelfExit.AllowValidatorSelfExit = false
+
+	testCases := []struct {
+		name         string
+		request      *poa.MsgUpdateParams
+		expectErrMsg string
+	}{
+		{
+			name: "set invalid authority (not an address)",
+			request: &poa.MsgUpdateParams{
+				Sender: "foo",
+			},
+			expectErrMsg: "not an authority",
+		},
+		{
+			name: "set invalid authority (not defined authority)",
+			request: &poa.MsgUpdateParams{
+				Sender: f.addrs[1].String(),
+				Params: poa.DefaultParams(),
+			},
+			expectErrMsg: "not an authority",
+		},
+		{
+			name: "set invalid admin power weight",
+			request: &poa.MsgUpdateParams{
+				Sender: f.authorityAddr,
+				Params: poa.Params{AllowValidatorSelfExit: false, AdminPowerWeight: sdkmath.LegacyZeroDec()},
+			},
+			expectErrMsg: "admin power weight must be between 0 and 1",
+		},
+		{
+			name: "set valid params",
+			request: &poa.MsgUpdateParams{
+				Sender: f.authorityAddr,
+				Params: noSelfExit,
+			},
+			expectErrMsg: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := f.msgServer.UpdateParams(f.ctx, tc.request)
+			if tc.expectErrMsg != "" {
+				require.Error(err)
+				require.ErrorContains(err, tc.expectErrMsg)
+				return
+			}
+
+			require.NoError(err)
+
+			r, err := f.queryServer.Params(f.ctx, &poa.QueryParamsRequest{})
+			require.NoError(err)
+			require.Equal(tc.request.Params, r.Params)
+
+			updated := requireTypedEvent[*poa.EventParamsUpdated](t, f.ctx)
+			require.Equal(poa.DefaultParams(), updated.OldParams)
+			require.Equal(tc.request.Params, updated.NewParams)
+		})
+	}
+}
+
 // Verifies that if the minimum commission rate is brought up, all validators with too low of a commission are updated.
 // i.e.:
 // - MinCommission is 0, change is now 1%, all validators below 1% are updated to 1%.
@@ -314,6 +473,14 @@ func TestRemoveValidator(t *testing.T) {
 			},
 			expectErrMsg: "is not bonded",
 		},
+		{
+			name: "fail; remove validator as itself when self exit is not allowed",
+			request: &poa.MsgRemoveValidator{
+				Sender:           sdk.AccAddress(MustValAddressFromBech32(vals[1].OperatorAddress)).String(),
+				ValidatorAddress: vals[1].OperatorAddress,
+			},
+			expectErrMsg: poa.ErrValidatorSelfExitNotAllowed.Error(),
+		},
 		{
 			name: "success; remove validator as itself",
 			request: &poa.MsgRemoveValidator{
@@ -336,6 +503,10 @@ func TestRemoveValidator(t *testing.T) {
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
+			params := poa.DefaultParams()
+			params.AllowValidatorSelfExit = tc.isSelfRemovalAllowed
+			require.NoError(f.k.SetParams(f.ctx, params))
+
 			_, err = f.msgServer.RemoveValidator(f.ctx, tc.request)
 
 			if tc.expectErrMsg != "" {
@@ -435,3 +606,115 @@ func TestMultipleUpdatesInASingleBlock(t *testing.T) {
 		})
 	}
 }
+
+func TestTypedEvents(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 100)
+	require.NoError(err)
+
+	// power set on an active validator
+	f.ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+		Power:            3_000_000,
+	})
+	require.NoError(err)
+
+	powerSet := requireTypedEvent[*poa.EventPowerSet](t, f.ctx)
+	require.Equal(f.authorityAddr, powerSet.Actor)
+	require.Equal(vals[0].OperatorAddress, powerSet.ValidatorAddress)
+	require.EqualValues(2, powerSet.OldPower)
+	require.EqualValues(3, powerSet.NewPower)
+	require.EqualValues(3_000_000, powerSet.Shares)
+	require.EqualValues(1, powerSet.ChangedInBlockPower)
+	require.EqualValues(6, powerSet.CachedBlockPower)
+
+	_, err = f.IncreaseBlock(1)
+	require.NoError(err)
+
+	// pending validator created, then accepted
+	f.ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+	valAddr := f.CreatePendingValidator("pending", 1_000_000)
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: valAddr.String(),
+		Power:            1_000_000,
+		Unsafe:           true,
+	})
+	require.NoError(err)
+
+	accepted := requireTypedEvent[*poa.EventValidatorAccepted](t, f.ctx)
+	require.Equal(valAddr.String(), accepted.ValidatorAddress)
+	require.EqualValues(1_000_000, accepted.Shares)
+
+	powerSet = requireTypedEvent[*poa.EventPowerSet](t, f.ctx)
+	require.EqualValues(0, powerSet.OldPower)
+	require.EqualValues(1, powerSet.NewPower)
+	require.True(powerSet.Unsafe)
+
+	_, err = f.IncreaseBlock(1)
+	require.NoError(err)
+
+	// validator removal
+	f.ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = f.msgServer.RemoveValidator(f.ctx, &poa.MsgRemoveValidator{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[1].OperatorAddress,
+	})
+	require.NoError(err)
+
+	removed := requireTypedEvent[*poa.EventValidatorRemoved](t, f.ctx)
+	require.Equal(vals[1].OperatorAddress, removed.ValidatorAddress)
+	require.EqualValues(2, removed.OldPower)
+	require.False(removed.SelfRemoval)
+
+	// pending removal
+	pendingAddr := f.CreatePendingValidator("pending-2", 1_000_000)
+	f.ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = f.msgServer.RemovePending(f.ctx, &poa.MsgRemovePending{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: pendingAddr.String(),
+	})
+	require.NoError(err)
+
+	pendingRemoved := requireTypedEvent[*poa.EventPendingRemoved](t, f.ctx)
+	require.Equal(pendingAddr.String(), pendingRemoved.ValidatorAddress)
+
+	// staking params update
+	p := poa.DefaultStakingParams()
+	p.MaxValidators = 50
+	f.ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = f.msgServer.UpdateStakingParams(f.ctx, &poa.MsgUpdateStakingParams{
+		Sender: f.authorityAddr,
+		Params: p,
+	})
+	require.NoError(err)
+
+	paramsUpdated := requireTypedEvent[*poa.EventStakingParamsUpdated](t, f.ctx)
+	require.EqualValues(100, paramsUpdated.OldParams.MaxValidators)
+	require.EqualValues(50, paramsUpdated.NewParams.MaxValidators)
+}
+
+// requireTypedEvent returns the first typed event of type T emitted in the context's event manager.
+func requireTypedEvent[T proto.Message](t *testing.T, ctx sdk.Context) T {
+	t.Helper()
+
+	for _, e := range ctx.EventManager().Events() {
+		parsed, err := sdk.ParseTypedEvent(abci.Event(e))
+		if err != nil {
+			continue
+		}
+
+		if typed, ok := parsed.(T); ok {
+			return typed
+		}
+	}
+
+	var empty T
+	require.Failf(t, "typed event not found", "%T", empty)
+
+	return empty
+}
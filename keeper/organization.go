@@ -0,0 +1,360 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+// SetOrganization registers a new organization or updates an existing one.
+func (k Keeper) SetOrganization(ctx context.Context, org poa.Organization) error {
+	if err := org.Validate(); err != nil {
+		return err
+	}
+
+	return k.Organizations.Set(ctx, org.Id, org)
+}
+
+// GetOrganization returns the organization registered under the given id.
+func (k Keeper) GetOrganization(ctx context.Context, id string) (poa.Organization, error) {
+	org, err := k.Organizations.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return poa.Organization{}, errorsmod.Wrapf(poa.ErrOrganizationNotFound, "organization %s", id)
+	}
+
+	return org, err
+}
+
+// GetValidatorOrganizationID returns the organization id of a validator, or an empty string if it has none.
+func (k Keeper) GetValidatorOrganizationID(ctx context.Context, valOpAddr string) (string, error) {
+	orgID, err := k.ValidatorOrganization.Get(ctx, valOpAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return "", nil
+	}
+
+	return orgID, err
+}
+
+// SetValidatorOrganization assigns a validator to an organization, replacing any previous assignment.
+// An empty organization id only removes the current assignment. The assignment is rejected if the validator's
+// power would push the organization above its maximum share of the total power.
+func (k Keeper) SetValidatorOrganization(ctx context.Context, valOpAddr string, orgID string) error {
+	valAddr, err := k.GetValidatorAddressCodec().StringToBytes(valOpAddr)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	valPower, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if orgID != "" {
+		org, err := k.GetOrganization(ctx, orgID)
+		if err != nil {
+			return err
+		}
+
+		currentOrgID, err := k.GetValidatorOrganizationID(ctx, valOpAddr)
+		if err != nil {
+			return err
+		}
+
+		orgPower, err := k.GetOrganizationPower(ctx, orgID)
+		if err != nil {
+			return err
+		}
+
+		if currentOrgID != orgID {
+			orgPower += valPower
+		}
+
+		totalPower, err := k.organizationTotalPower(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := checkOrganizationShare(org, orgPower, totalPower); err != nil {
+			return err
+		}
+	}
+
+	if err := k.RemoveValidatorOrganization(ctx, valOpAddr); err != nil {
+		return err
+	}
+
+	if orgID == "" {
+		return nil
+	}
+
+	if err := k.ValidatorOrganization.Set(ctx, valOpAddr, orgID); err != nil {
+		return err
+	}
+
+	if err := k.OrganizationValidators.Set(ctx, collections.Join(orgID, valOpAddr)); err != nil {
+		return err
+	}
+
+	return k.addOrganizationPower(ctx, orgID, valPower)
+}
+
+// CheckOrganizationShare verifies the current combined power of the organization's validators is within its maximum share.
+func (k Keeper) CheckOrganizationShare(ctx context.Context, org poa.Organization) error {
+	orgPower, err := k.GetOrganizationPower(ctx, org.Id)
+	if err != nil {
+		return err
+	}
+
+	totalPower, err := k.organizationTotalPower(ctx)
+	if err != nil {
+		return err
+	}
+
+	return checkOrganizationShare(org, orgPower, totalPower)
+}
+
+// RemoveValidatorOrganization removes a validator and its power from its organization, if any.
+func (k Keeper) RemoveValidatorOrganization(ctx context.Context, valOpAddr string) error {
+	orgID, err := k.GetValidatorOrganizationID(ctx, valOpAddr)
+	if err != nil || orgID == "" {
+		return err
+	}
+
+	valAddr, err := k.GetValidatorAddressCodec().StringToBytes(valOpAddr)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	valPower, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ValidatorOrganization.Remove(ctx, valOpAddr); err != nil {
+		return err
+	}
+
+	if err := k.OrganizationValidators.Remove(ctx, collections.Join(orgID, valOpAddr)); err != nil {
+		return err
+	}
+
+	return k.addOrganizationPower(ctx, orgID, -valPower)
+}
+
+// GetOrganizationValidators returns the operator addresses of all validators assigned to an organization.
+func (k Keeper) GetOrganizationValidators(ctx context.Context, orgID string) ([]string, error) {
+	iter, err := k.OrganizationValidators.Iterate(ctx, collections.NewPrefixedPairRange[string, string](orgID))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	vals := make([]string, 0)
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+
+		vals = append(vals, key.K2())
+	}
+
+	return vals, nil
+}
+
+// GetOrganizationPower returns the tracked combined consensus power of all validators in an organization.
+func (k Keeper) GetOrganizationPower(ctx context.Context, orgID string) (int64, error) {
+	power, err := k.OrganizationPower.Get(ctx, orgID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+
+	return power, err
+}
+
+// addOrganizationPower adds a change of the consensus power of its validators to the tracked organization power.
+func (k Keeper) addOrganizationPower(ctx context.Context, orgID string, delta int64) error {
+	if delta == 0 {
+		return nil
+	}
+
+	power, err := k.GetOrganizationPower(ctx, orgID)
+	if err != nil {
+		return err
+	}
+
+	return k.OrganizationPower.Set(ctx, orgID, power+delta)
+}
+
+// addValidatorOrganizationPower adds a change of the consensus power of a validator to its organization, if any.
+func (k Keeper) addValidatorOrganizationPower(ctx context.Context, valOpAddr string, delta int64) error {
+	orgID, err := k.GetValidatorOrganizationID(ctx, valOpAddr)
+	if err != nil || orgID == "" {
+		return err
+	}
+
+	return k.addOrganizationPower(ctx, orgID, delta)
+}
+
+// ReconcileOrganizationPower sets the tracked power of every organization to the last consensus power of its
+// validators. It picks up the power changes x/staking applies at the end of a block, e.g. a validator jailed by
+// x/slashing or the delegations of hybrid mode.
+func (k Keeper) ReconcileOrganizationPower(ctx context.Context) error {
+	var orgIDs []string
+	if err := k.Organizations.Walk(ctx, nil, func(id string, _ poa.Organization) (bool, error) {
+		orgIDs = append(orgIDs, id)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, orgID := range orgIDs {
+		vals, err := k.GetOrganizationValidators(ctx, orgID)
+		if err != nil {
+			return err
+		}
+
+		var total int64
+		for _, val := range vals {
+			valAddr, err := k.GetValidatorAddressCodec().StringToBytes(val)
+			if err != nil {
+				return err
+			}
+
+			power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+			if err != nil {
+				return err
+			}
+
+			total += power
+		}
+
+		if err := k.OrganizationPower.Set(ctx, orgID, total); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// organizationTotalPower returns the total consensus power the organization power is compared with. It is taken from
+// the tracked bonded tokens, which include the power changes made earlier in the block. In hybrid mode x/staking
+// applies the power changes at the end of the block, so it is the x/staking last total power.
+func (k Keeper) organizationTotalPower(ctx context.Context) (int64, error) {
+	hybrid, err := k.IsHybridMode(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if hybrid {
+		total, err := k.stakingKeeper.GetLastTotalPower(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		return total.Int64(), nil
+	}
+
+	bondedTokens, err := k.GetBondedTokens(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return k.stakingKeeper.TokensToConsensusPower(ctx, bondedTokens), nil
+}
+
+// hasOrganizations returns true if any organization is registered.
+func (k Keeper) hasOrganizations(ctx context.Context) (bool, error) {
+	iter, err := k.Organizations.Iterate(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	return iter.Valid(), nil
+}
+
+// checkOrganizationPowerLimit verifies that changing a validator's consensus power from currentPower to newPower keeps
+// every organization within its maximum share of the new total power. Lowering or removing a validator outside of an
+// organization grows the share of the organization, so all of them are checked. A change which lowers the share of an
+// organization is always allowed, so an over the limit organization can be brought back within bounds.
+func (k Keeper) checkOrganizationPowerLimit(ctx context.Context, valOpAddr string, currentPower, newPower int64) error {
+	if newPower == currentPower {
+		return nil
+	}
+
+	if hasOrgs, err := k.hasOrganizations(ctx); err != nil || !hasOrgs {
+		return err
+	}
+
+	valOrgID, err := k.GetValidatorOrganizationID(ctx, valOpAddr)
+	if err != nil {
+		return err
+	}
+
+	totalPower, err := k.organizationTotalPower(ctx)
+	if err != nil {
+		return err
+	}
+
+	newTotalPower := totalPower - currentPower + newPower
+
+	iter, err := k.Organizations.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		org, err := iter.Value()
+		if err != nil {
+			return err
+		}
+
+		orgPower, err := k.GetOrganizationPower(ctx, org.Id)
+		if err != nil {
+			return err
+		}
+
+		newOrgPower := orgPower
+		if org.Id == valOrgID {
+			newOrgPower = orgPower - currentPower + newPower
+		}
+
+		if err := checkOrganizationShare(org, newOrgPower, newTotalPower); err != nil && shareIncreased(orgPower, totalPower, newOrgPower, newTotalPower) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// shareIncreased returns true if the share orgPower/totalPower grows to newOrgPower/newTotalPower.
+func shareIncreased(orgPower, totalPower, newOrgPower, newTotalPower int64) bool {
+	if totalPower <= 0 {
+		return newOrgPower > 0
+	}
+
+	return sdkmath.NewInt(newOrgPower).MulRaw(totalPower).GT(sdkmath.NewInt(orgPower).MulRaw(newTotalPower))
+}
+
+// checkOrganizationShare returns an error if the organization power is above its maximum share of the total power.
+func checkOrganizationShare(org poa.Organization, orgPower, totalPower int64) error {
+	if orgPower <= 0 || totalPower <= 0 {
+		return nil
+	}
+
+	share := sdkmath.LegacyNewDec(orgPower).QuoInt64(totalPower)
+	if share.GT(org.MaxPowerShare) {
+		return errorsmod.Wrapf(poa.ErrOrganizationPowerExceeded, "organization %s would hold %s of the total power (max %s)", org.Id, share, org.MaxPowerShare)
+	}
+
+	return nil
+}
@@ -0,0 +1,295 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+func TestRegisterOrganization(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	org := poa.NewOrganization("acme", "Acme Corp", sdkmath.LegacyNewDecWithPrec(5, 1))
+
+	testCases := []struct {
+		name         string
+		request      *poa.MsgRegisterOrganization
+		expectErrMsg string
+	}{
+		{
+			name: "not an authority",
+			request: &poa.MsgRegisterOrganization{
+				Sender:       f.addrs[1].String(),
+				Organization: org,
+			},
+			expectErrMsg: "not an authority",
+		},
+		{
+			name: "invalid organization id",
+			request: &poa.MsgRegisterOrganization{
+				Sender:       f.authorityAddr,
+				Organization: poa.NewOrganization("acme corp", "Acme Corp", sdkmath.LegacyNewDecWithPrec(5, 1)),
+			},
+			expectErrMsg: "invalid organization",
+		},
+		{
+			name: "invalid max power share",
+			request: &poa.MsgRegisterOrganization{
+				Sender:       f.authorityAddr,
+				Organization: poa.NewOrganization("acme", "Acme Corp", sdkmath.LegacyZeroDec()),
+			},
+			expectErrMsg: "invalid organization",
+		},
+		{
+			name: "success",
+			request: &poa.MsgRegisterOrganization{
+				Sender:       f.authorityAddr,
+				Organization: org,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := f.msgServer.RegisterOrganization(f.ctx, tc.request)
+			if tc.expectErrMsg != "" {
+				require.Error(err)
+				require.ErrorContains(err, tc.expectErrMsg)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+
+	stored, err := f.k.GetOrganization(f.ctx, org.Id)
+	require.NoError(err)
+	require.True(org.Equal(stored))
+}
+
+func TestOrganizationPowerLimit(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 3)
+	require.NoError(err)
+	require.Len(vals, 3)
+
+	// each of the 3 base validators holds 2 of the 6 total power.
+	_, err = f.msgServer.RegisterOrganization(f.ctx, &poa.MsgRegisterOrganization{
+		Sender:       f.authorityAddr,
+		Organization: poa.NewOrganization("acme", "Acme Corp", sdkmath.LegacyNewDecWithPrec(5, 1)),
+	})
+	require.NoError(err)
+
+	// unknown organizations can not be assigned.
+	_, err = f.msgServer.SetValidatorOrganization(f.ctx, &poa.MsgSetValidatorOrganization{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+		OrganizationId:   "unknown",
+	})
+	require.ErrorIs(err, poa.ErrOrganizationNotFound)
+
+	// 2 + 2 of 6 total power is above the 50% limit.
+	for idx, expectErr := range []bool{false, true} {
+		_, err = f.msgServer.SetValidatorOrganization(f.ctx, &poa.MsgSetValidatorOrganization{
+			Sender:           f.authorityAddr,
+			ValidatorAddress: vals[idx].OperatorAddress,
+			OrganizationId:   "acme",
+		})
+		if expectErr {
+			require.ErrorIs(err, poa.ErrOrganizationPowerExceeded)
+		} else {
+			require.NoError(err)
+		}
+	}
+
+	orgVals, err := f.k.GetOrganizationValidators(f.ctx, "acme")
+	require.NoError(err)
+	require.Equal([]string{vals[0].OperatorAddress}, orgVals)
+
+	// 5 of 9 total power is above the 50% limit.
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+		Power:            5_000_000,
+		Unsafe:           true,
+	})
+	require.ErrorIs(err, poa.ErrOrganizationPowerExceeded)
+
+	// validators outside of the organization are not limited.
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[1].OperatorAddress,
+		Power:            5_000_000,
+		Unsafe:           true,
+	})
+	require.NoError(err)
+	_, err = f.IncreaseBlock(1)
+	require.NoError(err)
+
+	// 5 of 12 total power is within the 50% limit.
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+		Power:            5_000_000,
+		Unsafe:           true,
+	})
+	require.NoError(err)
+	_, err = f.IncreaseBlock(1)
+	require.NoError(err)
+
+	orgPower, err := f.k.GetOrganizationPower(f.ctx, "acme")
+	require.NoError(err)
+	require.EqualValues(5, orgPower)
+
+	// decreasing the power of an organization validator is always allowed.
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+		Power:            1_000_000,
+		Unsafe:           true,
+	})
+	require.NoError(err)
+
+	// an empty organization id removes the assignment.
+	_, err = f.msgServer.SetValidatorOrganization(f.ctx, &poa.MsgSetValidatorOrganization{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+		OrganizationId:   "",
+	})
+	require.NoError(err)
+
+	orgID, err := f.k.GetValidatorOrganizationID(f.ctx, vals[0].OperatorAddress)
+	require.NoError(err)
+	require.Empty(orgID)
+
+	orgVals, err = f.k.GetOrganizationValidators(f.ctx, "acme")
+	require.NoError(err)
+	require.Empty(orgVals)
+}
+
+func TestOrganizationPowerLimitNonMember(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 3)
+	require.NoError(err)
+	require.Len(vals, 3)
+
+	require.NoError(f.k.SetOrganization(f.ctx, poa.NewOrganization("acme", "Acme Corp", sdkmath.LegacyNewDecWithPrec(5, 1))))
+	require.NoError(f.k.SetValidatorOrganization(f.ctx, vals[0].OperatorAddress, "acme"))
+
+	// 2 of 5 total power is within the 50% limit.
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[1].OperatorAddress,
+		Power:            1_000_000,
+		Unsafe:           true,
+	})
+	require.NoError(err)
+	_, err = f.IncreaseBlock(1)
+	require.NoError(err)
+
+	// removing a validator outside of the organization leaves it with 2 of 3 total power, above the 50% limit.
+	_, err = f.msgServer.RemoveValidator(f.ctx, &poa.MsgRemoveValidator{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[2].OperatorAddress,
+	})
+	require.ErrorIs(err, poa.ErrOrganizationPowerExceeded)
+
+	// lowering it to 1 leaves the organization with 2 of 4 total power, at the 50% limit.
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[2].OperatorAddress,
+		Power:            1_000_000,
+		Unsafe:           true,
+	})
+	require.NoError(err)
+	_, err = f.IncreaseBlock(1)
+	require.NoError(err)
+
+	// the organization validator can still leave.
+	_, err = f.msgServer.RemoveValidator(f.ctx, &poa.MsgRemoveValidator{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+	})
+	require.NoError(err)
+}
+
+func TestOrganizationPowerTracking(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 3)
+	require.NoError(err)
+	require.Len(vals, 3)
+
+	requireOrgPower := func(expected int64) {
+		t.Helper()
+
+		power, err := f.k.GetOrganizationPower(f.ctx, "acme")
+		require.NoError(err)
+		require.Equal(expected, power)
+	}
+
+	require.NoError(f.k.SetOrganization(f.ctx, poa.NewOrganization("acme", "Acme Corp", sdkmath.LegacyOneDec())))
+	requireOrgPower(0)
+
+	// the power of a validator is added when it is assigned and removed when it leaves.
+	require.NoError(f.k.SetValidatorOrganization(f.ctx, vals[0].OperatorAddress, "acme"))
+	require.NoError(f.k.SetValidatorOrganization(f.ctx, vals[1].OperatorAddress, "acme"))
+	requireOrgPower(4)
+
+	require.NoError(f.k.RemoveValidatorOrganization(f.ctx, vals[1].OperatorAddress))
+	requireOrgPower(2)
+
+	// the power changes of its validators are tracked.
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+		Power:            5_000_000,
+		Unsafe:           true,
+	})
+	require.NoError(err)
+	requireOrgPower(5)
+
+	// a drift is reconciled from the last validator powers in the next begin block.
+	require.NoError(f.k.OrganizationPower.Set(f.ctx, "acme", 100))
+
+	_, err = f.IncreaseBlock(1)
+	require.NoError(err)
+	requireOrgPower(5)
+
+	_, err = f.msgServer.RemoveValidator(f.ctx, &poa.MsgRemoveValidator{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+	})
+	require.NoError(err)
+	requireOrgPower(0)
+}
+
+func TestRemovePendingRemovesOrganization(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	require.NoError(f.k.SetOrganization(f.ctx, poa.NewOrganization("acme", "", poa.DefaultMaxOrganizationPowerShare)))
+
+	valAddr := f.CreatePendingValidator("pending", 1_000_000)
+	require.NoError(f.k.SetValidatorOrganization(f.ctx, valAddr.String(), "acme"))
+
+	_, err := f.msgServer.RemovePending(f.ctx, &poa.MsgRemovePending{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: valAddr.String(),
+	})
+	require.NoError(err)
+
+	orgID, err := f.k.GetValidatorOrganizationID(f.ctx, valAddr.String())
+	require.NoError(err)
+	require.Empty(orgID)
+}
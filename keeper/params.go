@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+// GetParams returns the module parameters.
+func (k Keeper) GetParams(ctx context.Context) (poa.Params, error) {
+	return k.Params.Get(ctx)
+}
+
+// SetParams validates and sets the module parameters.
+func (k Keeper) SetParams(ctx context.Context, params poa.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	return k.Params.Set(ctx, params)
+}
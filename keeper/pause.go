@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+// IsPaused returns true if the guardian paused the validator set operations.
+func (k Keeper) IsPaused(ctx context.Context) (bool, error) {
+	paused, err := k.Paused.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	}
+
+	return paused, err
+}
+
+// CheckNotPaused returns ErrPaused if the validator set operations are paused.
+func (k Keeper) CheckNotPaused(ctx context.Context) error {
+	paused, err := k.IsPaused(ctx)
+	if err != nil {
+		return err
+	}
+
+	if paused {
+		return poa.ErrPaused
+	}
+
+	return nil
+}
+
+// checkPowerModesNotPaused returns ErrPaused if a params update changes the hybrid, virtual power or equal power mode
+// while paused. These rescale, mint or burn the bonded stake of the validators. Other params may still be updated.
+func (k Keeper) checkPowerModesNotPaused(ctx context.Context, prev, params poa.Params) error {
+	if prev.HybridMode == params.HybridMode &&
+		(!params.HybridMode || prev.AdminPowerWeight.Equal(params.AdminPowerWeight)) &&
+		prev.VirtualPower.Equal(params.VirtualPower) &&
+		prev.EqualPower.Equal(params.EqualPower) {
+		return nil
+	}
+
+	if err := k.CheckNotPaused(ctx); err != nil {
+		return errorsmod.Wrap(err, "the power modes can not be changed")
+	}
+
+	return nil
+}
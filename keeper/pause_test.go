@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/strangelove-ventures/poa"
+	"github.com/strangelove-ventures/poa/keeper"
+)
+
+func TestPause(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	admin := f.authorityAddr
+	guardian := f.addrs[2].String()
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 100)
+	require.NoError(err)
+
+	// the module can not be paused without a guardian.
+	_, err = f.msgServer.Pause(f.ctx, &poa.MsgPause{Guardian: guardian})
+	require.ErrorIs(err, poa.ErrNotGuardian)
+
+	f.k.SetGuardian(guardian)
+	msgServer := keeper.NewMsgServerImpl(f.k)
+	queryServer := keeper.NewQueryServerImpl(f.k)
+
+	_, err = msgServer.Pause(f.ctx, &poa.MsgPause{Guardian: admin})
+	require.ErrorIs(err, poa.ErrNotGuardian)
+
+	_, err = msgServer.Unpause(f.ctx, &poa.MsgUnpause{Authority: admin, Guardian: guardian})
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = msgServer.Pause(f.ctx, &poa.MsgPause{Guardian: guardian})
+	require.NoError(err)
+
+	_, err = msgServer.Pause(f.ctx, &poa.MsgPause{Guardian: guardian})
+	require.ErrorIs(err, poa.ErrPaused)
+
+	res, err := queryServer.Status(f.ctx, &poa.QueryStatusRequest{})
+	require.NoError(err)
+	require.True(res.Paused)
+	require.Equal(guardian, res.Guardian)
+
+	// the validator set operations are rejected.
+	_, err = msgServer.SetPower(f.ctx, &poa.MsgSetPower{Sender: admin, ValidatorAddress: vals[0].OperatorAddress, Power: 3_000_000})
+	require.ErrorIs(err, poa.ErrPaused)
+
+	_, err = msgServer.RemoveValidator(f.ctx, &poa.MsgRemoveValidator{Sender: admin, ValidatorAddress: vals[0].OperatorAddress})
+	require.ErrorIs(err, poa.ErrPaused)
+
+	_, err = msgServer.CreateValidator(f.ctx, &poa.MsgCreateValidator{})
+	require.ErrorIs(err, poa.ErrPaused)
+
+	_, err = msgServer.UpdateStakingParams(f.ctx, &poa.MsgUpdateStakingParams{Sender: admin, Params: poa.DefaultStakingParams()})
+	require.ErrorIs(err, poa.ErrPaused)
+
+	_, err = msgServer.UpdateSlashingParams(f.ctx, &poa.MsgUpdateSlashingParams{Sender: admin, Params: poa.DefaultSlashingParams()})
+	require.ErrorIs(err, poa.ErrPaused)
+
+	// unpausing requires both the admin and the guardian.
+	_, err = msgServer.Unpause(f.ctx, &poa.MsgUnpause{Authority: guardian, Guardian: guardian})
+	require.ErrorIs(err, poa.ErrNotAnAuthority)
+
+	_, err = msgServer.Unpause(f.ctx, &poa.MsgUnpause{Authority: admin, Guardian: admin})
+	require.ErrorIs(err, poa.ErrNotGuardian)
+
+	_, err = msgServer.Unpause(f.ctx, &poa.MsgUnpause{Authority: admin, Guardian: guardian})
+	require.NoError(err)
+
+	res, err = queryServer.Status(f.ctx, &poa.QueryStatusRequest{})
+	require.NoError(err)
+	require.False(res.Paused)
+
+	_, err = msgServer.SetPower(f.ctx, &poa.MsgSetPower{Sender: admin, ValidatorAddress: vals[0].OperatorAddress, Power: 3_000_000})
+	require.NoError(err)
+}
+
+// pause pauses the module with a guardian, it returns a msg server of the keeper with the guardian.
+func (f *testFixture) pause(t *testing.T) poa.MsgServer {
+	t.Helper()
+
+	guardian := f.addrs[2].String()
+	f.k.SetGuardian(guardian)
+	msgServer := keeper.NewMsgServerImpl(f.k)
+
+	_, err := msgServer.Pause(f.ctx, &poa.MsgPause{Guardian: guardian})
+	require.NoError(t, err)
+
+	return msgServer
+}
+
+func TestPausePowerModes(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	f.enableEqualPowerMode(t, 4_000_000)
+	msgServer := f.pause(t)
+
+	// the equal power rebalance is skipped while paused.
+	_, err := f.IncreaseBlock(3)
+	require.NoError(err)
+
+	for _, power := range f.validatorPowers(t) {
+		require.EqualValues(2, power)
+	}
+
+	// the power modes can not be changed while paused.
+	_, err = msgServer.UpdateParams(f.ctx, &poa.MsgUpdateParams{Sender: f.authorityAddr, Params: poa.DefaultParams()})
+	require.ErrorIs(err, poa.ErrPaused)
+
+	params := poa.DefaultParams()
+	params.EqualPower = poa.EqualPowerParams{Enabled: true, Power: 3_000_000}
+	_, err = msgServer.UpdateParams(f.ctx, &poa.MsgUpdateParams{Sender: f.authorityAddr, Params: params})
+	require.ErrorIs(err, poa.ErrPaused)
+
+	params = poa.DefaultParams()
+	params.HybridMode = true
+	params.BlockedMsgTypeUrls = poa.HybridBlockedMsgTypeURLs()
+	_, err = msgServer.UpdateParams(f.ctx, &poa.MsgUpdateParams{Sender: f.authorityAddr, Params: params})
+	require.ErrorIs(err, poa.ErrPaused)
+
+	params = poa.DefaultParams()
+	params.VirtualPower = poa.VirtualPowerParams{Enabled: true, Denom: "upower"}
+	_, err = msgServer.UpdateParams(f.ctx, &poa.MsgUpdateParams{Sender: f.authorityAddr, Params: params})
+	require.ErrorIs(err, poa.ErrPaused)
+
+	// the other params can still be updated.
+	params = poa.DefaultParams()
+	params.EqualPower = poa.EqualPowerParams{Enabled: true, Power: 4_000_000}
+	params.AllowValidatorSelfExit = false
+	_, err = msgServer.UpdateParams(f.ctx, &poa.MsgUpdateParams{Sender: f.authorityAddr, Params: params})
+	require.NoError(err)
+
+	// the rebalance resumes once unpaused.
+	_, err = msgServer.Unpause(f.ctx, &poa.MsgUnpause{Authority: f.authorityAddr, Guardian: f.addrs[2].String()})
+	require.NoError(err)
+
+	_, err = f.IncreaseBlock(1)
+	require.NoError(err)
+
+	changed := 0
+	for _, power := range f.validatorPowers(t) {
+		if power != 2 {
+			changed++
+		}
+	}
+	require.Equal(1, changed)
+}
+
+func TestPauseDowntimePolicy(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 100)
+	require.NoError(err)
+
+	f.setDowntimePolicy(t, poa.DowntimeActionStandby)
+	msgServer := f.pause(t)
+	f.setMissedBlocks(t, vals[0], 100)
+
+	// the downtime policy is skipped while paused.
+	f.ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = f.IncreaseBlock(1)
+	require.NoError(err)
+
+	require.Empty(f.ctx.EventManager().Events())
+	require.EqualValues(2, f.validatorPowers(t)[vals[0].OperatorAddress])
+
+	// the policy applies once unpaused.
+	_, err = msgServer.Unpause(f.ctx, &poa.MsgUnpause{Authority: f.authorityAddr, Guardian: f.addrs[2].String()})
+	require.NoError(err)
+
+	_, err = f.IncreaseBlock(1)
+	require.NoError(err)
+
+	isPending, err := f.k.IsValidatorPending(f.ctx, vals[0].OperatorAddress)
+	require.NoError(err)
+	require.True(isPending)
+}
@@ -2,11 +2,14 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"cosmossdk.io/collections"
+
 	"github.com/strangelove-ventures/poa"
 )
 
@@ -27,45 +30,27 @@ func (k Keeper) AddPendingValidator(ctx context.Context, newVal stakingtypes.Val
 	newVal.ConsensusPubkey = pkAny
 	poaVal := poa.ConvertStakingToPOA(newVal)
 
-	vals, err := k.GetPendingValidators(ctx)
-	if err != nil {
+	if isPending, err := k.PendingValidators.Has(ctx, poaVal.OperatorAddress); err != nil {
 		return err
+	} else if isPending {
+		return poa.ErrValidatorAlreadyPending
 	}
 
-	for _, val := range vals.Validators {
-		if val.OperatorAddress == poaVal.OperatorAddress {
-			return poa.ErrValidatorAlreadyPending
-		}
-	}
-
-	vals.Validators = append(vals.Validators, poaVal)
-
-	return k.PendingValidators.Set(ctx, vals)
+	return k.PendingValidators.Set(ctx, poaVal.OperatorAddress, poaVal)
 }
 
 func (k Keeper) RemovePendingValidator(ctx context.Context, valOpAddr string) error {
-	pending, err := k.GetPendingValidators(ctx)
-	if err != nil {
-		return err
-	}
-
-	vals := pending.Validators
-
-	for i, val := range vals {
-		if val.OperatorAddress == valOpAddr {
-			vals = append(vals[:i], vals[i+1:]...)
-			pending.Validators = vals
-			break
-		}
-	}
-
-	return k.PendingValidators.Set(ctx, pending)
+	return k.PendingValidators.Remove(ctx, valOpAddr)
 }
 
-// GetPendingValidators
+// GetPendingValidators returns all pending validators, ordered by operator address.
 func (k Keeper) GetPendingValidators(ctx context.Context) (poa.Validators, error) {
-	pending, err := k.PendingValidators.Get(ctx)
-	if err != nil {
+	pending := DefaultPendingValidators()
+
+	if err := k.PendingValidators.Walk(ctx, nil, func(_ string, val poa.Validator) (bool, error) {
+		pending.Validators = append(pending.Validators, val)
+		return false, nil
+	}); err != nil {
 		return DefaultPendingValidators(), err
 	}
 
@@ -73,30 +58,21 @@ func (k Keeper) GetPendingValidators(ctx context.Context) (poa.Validators, error
 }
 
 func (k Keeper) GetPendingValidator(ctx context.Context, operatorAddr string) (poa.Validator, error) {
-	pending, err := k.GetPendingValidators(ctx)
-	if err != nil {
+	val, err := k.PendingValidators.Get(ctx, operatorAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return poa.Validator{}, nil
+	} else if err != nil {
 		return poa.Validator{}, err
 	}
 
-	for _, val := range pending.Validators {
-		if val.OperatorAddress == operatorAddr {
-			// required to unpack the pubKey properly
-			if err := val.UnpackInterfaces(k.cdc); err != nil {
-				return poa.Validator{}, err
-			}
-
-			return val, nil
-		}
+	// required to unpack the pubKey properly
+	if err := val.UnpackInterfaces(k.cdc); err != nil {
+		return poa.Validator{}, err
 	}
 
-	return poa.Validator{}, nil
+	return val, nil
 }
 
 func (k Keeper) IsValidatorPending(ctx context.Context, operatorAddr string) (bool, error) {
-	pending, err := k.GetPendingValidator(ctx, operatorAddr)
-	if err != nil {
-		return false, err
-	}
-
-	return pending.OperatorAddress == operatorAddr, nil
+	return k.PendingValidators.Has(ctx, operatorAddr)
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -21,6 +22,20 @@ func (k Keeper) UpdateValidatorSet(ctx context.Context, newShares uint64, newCon
 	newShare := sdkmath.LegacyNewDecFromInt(newShareInt)
 
 	delAddr := sdk.AccAddress(valAddr.Bytes())
+
+	// the tracked bonded tokens are read before the delegation is replaced, a first read reconciles them from it.
+	bondedTokens, err := k.GetBondedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	prevShares := sdkmath.ZeroInt()
+	if prev, err := k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr); err == nil {
+		prevShares = prev.Shares.RoundInt()
+	} else if !errors.Is(err, stakingtypes.ErrNoDelegation) {
+		return err
+	}
+
 	delegation := stakingtypes.Delegation{
 		DelegatorAddress: delAddr.String(),
 		ValidatorAddress: val.OperatorAddress,
@@ -42,7 +57,7 @@ func (k Keeper) UpdateValidatorSet(ctx context.Context, newShares uint64, newCon
 		return err
 	}
 
-	return k.updateTotalPower(ctx)
+	return k.updateTotalPower(ctx, bondedTokens.Add(newShareInt).Sub(prevShares))
 }
 
 // SetPower sets a validator's self token delegation and the consensus power for the network.
@@ -52,7 +67,18 @@ func (k Keeper) UpdateValidatorSet(ctx context.Context, newShares uint64, newCon
 // - sets a single delegation for POA power
 // - updates the validator with the new shares, single delegation
 // - sets the last validator power to the new value.
+//
+// In hybrid mode the new shares are the admin assigned power of the validator instead, see setPOAPowerHybrid.
 func (k Keeper) SetPOAPower(ctx context.Context, valOpBech32 string, newShares uint64) (stakingtypes.Validator, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	if params.HybridMode {
+		return k.setPOAPowerHybrid(ctx, params, valOpBech32, newShares)
+	}
+
 	// 1 Consenus Power = 1_000_000 shares by default
 	amt := sdkmath.NewIntFromUint64(newShares)
 	newBFTConsensusPower := k.stakingKeeper.TokensToConsensusPower(ctx, amt)
@@ -78,6 +104,16 @@ func (k Keeper) SetPOAPower(ctx context.Context, valOpBech32 string, newShares u
 		return val, fmt.Errorf("current power (%d) is the same as the new power (%d) for %s", currentPower, newBFTConsensusPower, valOpBech32)
 	}
 
+	// Organizations may not exceed their maximum share of the total power through any of their validators, the change
+	// is then tracked in the power of the validator's organization.
+	if err := k.checkOrganizationPowerLimit(ctx, valOpBech32, currentPower, newBFTConsensusPower); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	if err := k.addValidatorOrganizationPower(ctx, valOpBech32, newBFTConsensusPower-currentPower); err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
 	// When we SetValidatorByPowerIndex, the Tokens are used to get the shares of power for CometBFT consensus (voting_power).
 	// We don't `k.stakingKeeper.SetValidator` since we only use this for CometBFT consensus power.
 	val.Tokens = amt
@@ -105,8 +141,10 @@ func (k Keeper) SetPOAPower(ctx context.Context, valOpBech32 string, newShares u
 		if err := k.stakingKeeper.DeleteValidatorByPowerIndex(ctx, val); err != nil {
 			return stakingtypes.Validator{}, err
 		}
-		if err := k.slashKeeper.DeleteMissedBlockBitmap(ctx, consAddr); err != nil {
-			return stakingtypes.Validator{}, err
+		if k.slashKeeper != nil {
+			if err := k.slashKeeper.DeleteMissedBlockBitmap(ctx, consAddr); err != nil {
+				return stakingtypes.Validator{}, err
+			}
 		}
 	} else {
 		// Sets the new consensus power for the validator (this is executed in the x/staking ApplyAndReturnValidatorUpdates method)
@@ -156,8 +194,34 @@ func (k Keeper) AcceptNewValidator(ctx context.Context, operatingAddress string,
 	// convert the pending POA validator into a staking module validator
 	val := poa.ConvertPOAToStaking(poaVal)
 
-	// setup the validator into the state and base power
-	if err := k.setValidatorInternals(ctx, val); err != nil {
+	// a pending validator may reuse the consensus key of a validator banned after it was created.
+	consAddr, err := val.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	if err := k.checkNotBanned(ctx, "", consAddr); err != nil {
+		return err
+	}
+
+	valAddr, err := k.GetValidatorAddressCodec().StringToBytes(val.OperatorAddress)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	// a validator put on standby by the downtime policy still exists in x/staking until its unbonding matures.
+	prev, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	switch {
+	case err == nil:
+		if err := k.rebondValidator(ctx, prev, val); err != nil {
+			return err
+		}
+	case errors.Is(err, stakingtypes.ErrNoValidatorFound):
+		// setup the validator into the state and base power
+		if err := k.setValidatorInternals(ctx, val); err != nil {
+			return err
+		}
+	default:
 		return err
 	}
 
@@ -211,25 +275,68 @@ func (k Keeper) setValidatorInternals(ctx context.Context, val stakingtypes.Vali
 	return k.stakingKeeper.Hooks().AfterValidatorCreated(ctx, valAddr)
 }
 
-// UpdateTotalPower sets the new LastTotalPower for the consensus power params.
-// It is reduced by the power reduction fraction (default: 10^6) to fit within BFT consensus limits.
-func (k Keeper) updateTotalPower(ctx context.Context) error {
-	allVals, err := k.stakingKeeper.GetAllValidators(ctx)
-	if err != nil {
-		return err
+// rebondValidator sets a validator which still exists in x/staking back into the state, without creating it again.
+// It is removed from the unbonding queue, the validator is bonded again by its new POA power.
+func (k Keeper) rebondValidator(ctx context.Context, prev, val stakingtypes.Validator) error {
+	if prev.IsUnbonding() {
+		if err := k.stakingKeeper.DeleteValidatorQueue(ctx, prev); err != nil {
+			return err
+		}
 	}
 
-	// summation of all validator tokens
-	allTokens := sdkmath.ZeroInt()
-	for _, val := range allVals {
-		allTokens = allTokens.Add(val.Tokens)
+	return k.stakingKeeper.SetValidator(ctx, val)
+}
+
+// UpdateTotalPower sets the new bonded tokens of the set and the LastTotalPower for the consensus power params.
+// It is reduced by the power reduction fraction (default: 10^6) to fit within BFT consensus limits.
+func (k Keeper) updateTotalPower(ctx context.Context, bondedTokens sdkmath.Int) error {
+	if err := k.BondedTokens.Set(ctx, bondedTokens); err != nil {
+		return err
 	}
 
 	// all tokens / 10^6 = new total power
-	totalConsenusPower := allTokens.Quo(k.stakingKeeper.PowerReduction(ctx))
+	totalConsenusPower := bondedTokens.Quo(k.stakingKeeper.PowerReduction(ctx))
 	if err := k.stakingKeeper.SetLastTotalPower(ctx, totalConsenusPower); err != nil {
 		return err
 	}
 
 	return k.UpdateBondedPoolPower(ctx)
 }
+
+// activeValidator is a validator of the active set with its last consensus power.
+type activeValidator struct {
+	val   stakingtypes.Validator
+	power int64
+}
+
+// activeValidators returns the validators with a last consensus power which are not jailed, ordered by operator
+// address. Validators removed from the set have no last power left.
+func (k Keeper) activeValidators(ctx context.Context) ([]activeValidator, error) {
+	vals, err := k.stakingKeeper.GetAllValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	active := make([]activeValidator, 0, len(vals))
+	for _, val := range vals {
+		if val.IsJailed() {
+			continue
+		}
+
+		valAddr, err := k.GetValidatorAddressCodec().StringToBytes(val.OperatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		if power > 0 {
+			active = append(active, activeValidator{val: val, power: power})
+		}
+	}
+
+	return active, nil
+}
@@ -4,6 +4,10 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"cosmossdk.io/collections"
 
 	"github.com/strangelove-ventures/poa"
 )
@@ -53,3 +57,139 @@ func (qs queryServer) PendingValidators(ctx context.Context, _ *poa.QueryPending
 func (qs queryServer) PoaAuthority(ctx context.Context, _ *poa.QueryPoaAuthorityRequest) (*poa.QueryPoaAuthorityResponse, error) {
 	return &poa.QueryPoaAuthorityResponse{Authority: qs.k.GetAdmin(ctx)}, nil
 }
+
+// Organizations returns all registered organizations.
+func (qs queryServer) Organizations(ctx context.Context, req *poa.QueryOrganizationsRequest) (*poa.QueryOrganizationsResponse, error) {
+	orgs, pageRes, err := query.CollectionPaginate(ctx, qs.k.Organizations, req.Pagination, func(_ string, org poa.Organization) (poa.Organization, error) {
+		return org, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &poa.QueryOrganizationsResponse{Organizations: orgs, Pagination: pageRes}, nil
+}
+
+// OrganizationPower returns the validators and combined consensus power of an organization.
+func (qs queryServer) OrganizationPower(ctx context.Context, req *poa.QueryOrganizationPowerRequest) (*poa.QueryOrganizationPowerResponse, error) {
+	org, err := qs.k.GetOrganization(ctx, req.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	vals, err := qs.k.GetOrganizationValidators(ctx, req.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	power, err := qs.k.GetOrganizationPower(ctx, req.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPower, err := qs.k.stakingKeeper.GetLastTotalPower(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &poa.QueryOrganizationPowerResponse{
+		Organization:        org,
+		Validators:          vals,
+		ConsensusPower:      power,
+		TotalConsensusPower: totalPower.Int64(),
+	}, nil
+}
+
+// AuditLog returns the audit log entries, optionally filtered by action and validator.
+func (qs queryServer) AuditLog(ctx context.Context, req *poa.QueryAuditLogRequest) (*poa.QueryAuditLogResponse, error) {
+	entries, pageRes, err := query.CollectionFilteredPaginate(ctx, qs.k.AuditLog, req.Pagination, func(_ uint64, entry poa.AuditLogEntry) (bool, error) {
+		if req.Action != "" && entry.Action != req.Action {
+			return false, nil
+		}
+
+		return req.ValidatorAddress == "" || entry.ValidatorAddress == req.ValidatorAddress, nil
+	}, func(_ uint64, entry poa.AuditLogEntry) (poa.AuditLogEntry, error) {
+		return entry, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &poa.QueryAuditLogResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// Params returns the module parameters.
+func (qs queryServer) Params(ctx context.Context, _ *poa.QueryParamsRequest) (*poa.QueryParamsResponse, error) {
+	params, err := qs.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &poa.QueryParamsResponse{Params: params}, nil
+}
+
+// AccountAllowlist returns the accounts and address prefixes of the account allowlist.
+func (qs queryServer) AccountAllowlist(ctx context.Context, req *poa.QueryAccountAllowlistRequest) (*poa.QueryAccountAllowlistResponse, error) {
+	accounts, pageRes, err := query.CollectionPaginate(ctx, qs.k.AllowedAccounts, req.Pagination, func(account string, _ collections.NoValue) (string, error) {
+		return account, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	prefixes, err := qs.k.GetAllowedPrefixes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &poa.QueryAccountAllowlistResponse{Accounts: accounts, Prefixes: prefixes, Pagination: pageRes}, nil
+}
+
+// MaintenanceMode returns the maintenance mode and whether it is active.
+func (qs queryServer) MaintenanceMode(ctx context.Context, _ *poa.QueryMaintenanceModeRequest) (*poa.QueryMaintenanceModeResponse, error) {
+	mode, err := qs.k.GetMaintenanceMode(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &poa.QueryMaintenanceModeResponse{
+		MaintenanceMode: mode,
+		Active:          mode.IsActive(sdk.UnwrapSDKContext(ctx).BlockHeight()),
+	}, nil
+}
+
+// Status returns whether the validator set operations are paused, and the guardian allowed to pause them.
+func (qs queryServer) Status(ctx context.Context, _ *poa.QueryStatusRequest) (*poa.QueryStatusResponse, error) {
+	paused, err := qs.k.IsPaused(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &poa.QueryStatusResponse{Paused: paused, Guardian: qs.k.GetGuardian()}, nil
+}
+
+// FeeRecipient returns the accrued and paid fees of an account, empty if it was never paid.
+func (qs queryServer) FeeRecipient(ctx context.Context, req *poa.QueryFeeRecipientRequest) (*poa.QueryFeeRecipientResponse, error) {
+	if _, err := qs.k.GetAccountAddressCodec().StringToBytes(req.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	recipient, err := qs.k.GetFeeRecipient(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &poa.QueryFeeRecipientResponse{FeeRecipient: recipient}, nil
+}
+
+// FeeRecipients returns the accrued and paid fees of all the accounts paid by the fee distribution.
+func (qs queryServer) FeeRecipients(ctx context.Context, req *poa.QueryFeeRecipientsRequest) (*poa.QueryFeeRecipientsResponse, error) {
+	recipients, pageRes, err := query.CollectionPaginate(ctx, qs.k.FeeRecipients, req.Pagination, func(_ string, recipient poa.FeeRecipient) (poa.FeeRecipient, error) {
+		return recipient, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &poa.QueryFeeRecipientsResponse{FeeRecipients: recipients, Pagination: pageRes}, nil
+}
@@ -63,3 +63,30 @@ func TestPOAAuthorityQuery(t *testing.T) {
 	require.NotEmpty(r.Authority)
 	require.Equal(currAdmin, r.Authority)
 }
+
+func TestOrganizationQueries(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 1)
+	require.NoError(err)
+
+	for _, id := range []string{"acme", "globex"} {
+		require.NoError(f.k.SetOrganization(f.ctx, poa.NewOrganization(id, "", poa.DefaultMaxOrganizationPowerShare)))
+	}
+	require.NoError(f.k.SetValidatorOrganization(f.ctx, vals[0].OperatorAddress, "acme"))
+
+	r, err := f.queryServer.Organizations(f.ctx, &poa.QueryOrganizationsRequest{})
+	require.NoError(err)
+	require.Len(r.Organizations, 2)
+
+	p, err := f.queryServer.OrganizationPower(f.ctx, &poa.QueryOrganizationPowerRequest{OrganizationId: "acme"})
+	require.NoError(err)
+	require.Equal("acme", p.Organization.Id)
+	require.Equal([]string{vals[0].OperatorAddress}, p.Validators)
+	require.EqualValues(2, p.ConsensusPower)
+	require.EqualValues(6, p.TotalConsensusPower)
+
+	_, err = f.queryServer.OrganizationPower(f.ctx, &poa.QueryOrganizationPowerRequest{OrganizationId: "unknown"})
+	require.Error(err)
+}
@@ -8,8 +8,13 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// setSlashingInfo sets validator slashing defaults (useful for downtime jailing)
+// setSlashingInfo sets validator slashing defaults (useful for downtime jailing). It is skipped on chains without
+// x/slashing.
 func (k Keeper) setSlashingInfo(sdkCtx sdk.Context, val stakingtypes.Validator) error {
+	if k.slashKeeper == nil {
+		return nil
+	}
+
 	cons, err := val.GetConsAddr()
 	if err != nil {
 		return err
@@ -26,7 +31,13 @@ func (k Keeper) setSlashingInfo(sdkCtx sdk.Context, val stakingtypes.Validator)
 	})
 }
 
+// clearSlashingInfo removes the missed blocks and signing info of a removed validator. It is skipped on chains without
+// x/slashing.
 func (k Keeper) clearSlashingInfo(ctx context.Context, val stakingtypes.Validator) error {
+	if k.slashKeeper == nil {
+		return nil
+	}
+
 	cons, err := val.GetConsAddr()
 	if err != nil {
 		return err
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+// In virtual power mode the validator power is backed by a dedicated power denom instead of the fee or utility token
+// of the chain. x/staking still needs its pools to hold the bonded tokens for jailing and slashing, so POA keeps minting
+// them, but in a denom which only the staking pools hold and which can not be transferred. The supply of the other
+// tokens is then not inflated by the validator power.
+
+// updateVirtualPower applies a change of the virtual power mode params.
+// - enabling it, or changing the power denom, converts the staking pools into the power denom
+// - disabling it keeps the power denom as the bond denom, and allows its transfers again
+func (k Keeper) updateVirtualPower(ctx context.Context, prev, params poa.Params) error {
+	prevVP, vp := prev.VirtualPower, params.VirtualPower
+
+	switch {
+	case vp.Enabled && (!prevVP.Enabled || prevVP.Denom != vp.Denom):
+		return k.enableVirtualPower(ctx, vp.Denom)
+	case prevVP.Enabled && !vp.Enabled:
+		k.bankKeeper.SetSendEnabled(ctx, prevVP.Denom, true)
+		return nil
+	default:
+		return nil
+	}
+}
+
+// enableVirtualPower converts the staking pools into the power denom and makes it the bond denom. The converted pool
+// tokens of the previous bond denom are burned, they were minted by POA for the validator power.
+func (k Keeper) enableVirtualPower(ctx context.Context, denom string) error {
+	pools := []string{stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName}
+
+	// the power denom may only be held by the staking pools, or transfers would be blocked for its holders.
+	poolSupply := sdkmath.ZeroInt()
+	for _, pool := range pools {
+		poolSupply = poolSupply.Add(k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(pool), denom).Amount)
+	}
+
+	if supply := k.bankKeeper.GetSupply(ctx, denom); !supply.Amount.Equal(poolSupply) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "virtual power denom %s has a supply outside of the staking pools", denom)
+	}
+
+	stakingParams, err := k.stakingKeeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if stakingParams.BondDenom != denom {
+		balances := make([]sdkmath.Int, len(pools))
+		for i, pool := range pools {
+			balances[i] = k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(pool), stakingParams.BondDenom).Amount
+			if err := k.adjustPool(ctx, pool, balances[i].Neg()); err != nil {
+				return err
+			}
+		}
+
+		stakingParams.BondDenom = denom
+		if err := k.stakingKeeper.SetParams(ctx, stakingParams); err != nil {
+			return err
+		}
+
+		for i, pool := range pools {
+			if err := k.adjustPool(ctx, pool, balances[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	k.bankKeeper.SetSendEnabled(ctx, denom, false)
+
+	return nil
+}
+
+// initVirtualPower blocks the transfers of the power denom of a genesis in virtual power mode, which must already be
+// the bond denom.
+func (k Keeper) initVirtualPower(ctx context.Context, vp poa.VirtualPowerParams) error {
+	if !vp.Enabled {
+		return nil
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	if bondDenom != vp.Denom {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "virtual power denom %s must be the bond denom %s at genesis", vp.Denom, bondDenom)
+	}
+
+	k.bankKeeper.SetSendEnabled(ctx, vp.Denom, false)
+
+	return nil
+}
+
+// validateVirtualPowerBondDenom checks the bond denom stays the power denom in virtual power mode.
+func (k Keeper) validateVirtualPowerBondDenom(ctx context.Context, bondDenom string) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.VirtualPower.Enabled && params.VirtualPower.Denom != bondDenom {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "bond denom must be the virtual power denom %s, got %s", params.VirtualPower.Denom, bondDenom)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+func (f *testFixture) setVirtualPower(vp poa.VirtualPowerParams) error {
+	params := poa.DefaultParams()
+	params.VirtualPower = vp
+
+	_, err := f.msgServer.UpdateParams(f.ctx, &poa.MsgUpdateParams{Sender: f.authorityAddr, Params: params})
+	return err
+}
+
+func TestVirtualPowerParams(t *testing.T) {
+	testCases := []struct {
+		name         string
+		virtualPower poa.VirtualPowerParams
+		hybridMode   bool
+		expectErrMsg string
+	}{
+		{
+			name:         "fail: invalid denom",
+			virtualPower: poa.VirtualPowerParams{Enabled: true, Denom: "1"},
+			expectErrMsg: "invalid virtual power denom",
+		},
+		{
+			name:         "fail: with hybrid mode",
+			virtualPower: poa.VirtualPowerParams{Enabled: true, Denom: "upower"},
+			hybridMode:   true,
+			expectErrMsg: "can not be enabled together",
+		},
+		{
+			name:         "disabled without denom",
+			virtualPower: poa.VirtualPowerParams{},
+		},
+		{
+			name:         "enabled",
+			virtualPower: poa.VirtualPowerParams{Enabled: true, Denom: "upower"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := poa.DefaultParams()
+			params.VirtualPower = tc.virtualPower
+			params.HybridMode = tc.hybridMode
+
+			err := params.Validate()
+			if tc.expectErrMsg != "" {
+				require.ErrorContains(t, err, tc.expectErrMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestVirtualPower(t *testing.T) {
+	f := SetupTest(t, 2_000_000)
+	require := require.New(t)
+
+	vals, err := f.stakingKeeper.GetValidators(f.ctx, 100)
+	require.NoError(err)
+
+	bondedPool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName)
+	bonded := f.bankkeeper.GetBalance(f.ctx, bondedPool, "stake").Amount
+	stakeSupply := f.bankkeeper.GetSupply(f.ctx, "stake").Amount.Sub(bonded)
+
+	// a denom held outside of the staking pools can not back the power.
+	coins := sdk.NewCoins(sdk.NewInt64Coin("utoken", 100))
+	require.NoError(f.bankkeeper.MintCoins(f.ctx, minttypes.ModuleName, coins))
+	require.ErrorContains(f.setVirtualPower(poa.VirtualPowerParams{Enabled: true, Denom: "utoken"}), "supply outside of the staking pools")
+
+	// the bonded pool is converted into the power denom.
+	require.NoError(f.setVirtualPower(poa.VirtualPowerParams{Enabled: true, Denom: "upower"}))
+
+	bondDenom, err := f.stakingKeeper.BondDenom(f.ctx)
+	require.NoError(err)
+	require.Equal("upower", bondDenom)
+
+	require.Zero(f.bankkeeper.GetBalance(f.ctx, bondedPool, "stake").Amount.Int64())
+	require.Equal(bonded.Int64(), f.bankkeeper.GetBalance(f.ctx, bondedPool, "upower").Amount.Int64())
+	require.Equal(stakeSupply.Int64(), f.bankkeeper.GetSupply(f.ctx, "stake").Amount.Int64())
+	require.False(f.bankkeeper.IsSendEnabledDenom(f.ctx, "upower"))
+
+	// new power is minted in the power denom only.
+	_, err = f.msgServer.SetPower(f.ctx, &poa.MsgSetPower{
+		Sender:           f.authorityAddr,
+		ValidatorAddress: vals[0].OperatorAddress,
+		Power:            bonded.Uint64(),
+		Unsafe:           true,
+	})
+	require.NoError(err)
+
+	tracked, err := f.k.GetBondedTokens(f.ctx)
+	require.NoError(err)
+	require.Equal(tracked.Int64(), f.bankkeeper.GetBalance(f.ctx, bondedPool, "upower").Amount.Int64())
+	require.Equal(stakeSupply.Int64(), f.bankkeeper.GetSupply(f.ctx, "stake").Amount.Int64())
+
+	// the bond denom stays the power denom.
+	stakingParams := poa.DefaultStakingParams()
+	_, err = f.msgServer.UpdateStakingParams(f.ctx, &poa.MsgUpdateStakingParams{Sender: f.authorityAddr, Params: stakingParams})
+	require.ErrorContains(err, "bond denom must be the virtual power denom")
+
+	stakingParams.BondDenom = "upower"
+	_, err = f.msgServer.UpdateStakingParams(f.ctx, &poa.MsgUpdateStakingParams{Sender: f.authorityAddr, Params: stakingParams})
+	require.NoError(err)
+
+	// disabling the mode keeps the bond denom and allows its transfers.
+	require.NoError(f.setVirtualPower(poa.VirtualPowerParams{}))
+	require.True(f.bankkeeper.IsSendEnabledDenom(f.ctx, "upower"))
+
+	bondDenom, err = f.stakingKeeper.BondDenom(f.ctx)
+	require.NoError(err)
+	require.Equal("upower", bondDenom)
+}
@@ -8,8 +8,9 @@ var (
 	// ParamsKey saves the current module params.
 	ParamsKey = collections.NewPrefix(0)
 
-	// PendingValidatorsKey saves the current pending validators.
-	PendingValidatorsKey = collections.NewPrefix(1)
+	// PendingValidatorsKey saves the current pending validators by operator address.
+	// Prefix 1 held the pending validators as a single list before consensus version 2.
+	PendingValidatorsKey = collections.NewPrefix(10)
 
 	// CachedPreviousBlockPowerKey saves the previous blocks total power amount.
 	CachedPreviousBlockPowerKey = collections.NewPrefix(2)
@@ -20,6 +21,61 @@ var (
 
 	// UpdatedValidatorsCacheKey tracks recently updated validators from SetPower.
 	UpdatedValidatorsCacheKey = collections.NewPrefix(4)
+
+	// OrganizationsKey saves the registered organizations by id.
+	OrganizationsKey = collections.NewPrefix(5)
+
+	// ValidatorOrganizationKey maps a validator operator address to its organization id.
+	ValidatorOrganizationKey = collections.NewPrefix(6)
+
+	// OrganizationValidatorsKey indexes the (organization id, validator operator address) pairs.
+	OrganizationValidatorsKey = collections.NewPrefix(7)
+
+	// AuditLogSequenceKey saves the id of the next audit log entry.
+	AuditLogSequenceKey = collections.NewPrefix(8)
+
+	// AuditLogKey saves the audit log entries by id.
+	AuditLogKey = collections.NewPrefix(9)
+
+	// AdminPowerKey saves the admin assigned power of the validators in hybrid mode.
+	AdminPowerKey = collections.NewPrefix(11)
+
+	// AllowedAccountsKey saves the accounts of the account allowlist.
+	AllowedAccountsKey = collections.NewPrefix(12)
+
+	// AllowedPrefixesKey saves the bech32 address prefixes of the account allowlist.
+	AllowedPrefixesKey = collections.NewPrefix(13)
+
+	// MaintenanceModeKey saves the maintenance mode of the chain.
+	MaintenanceModeKey = collections.NewPrefix(14)
+
+	// PausedKey saves whether the validator set operations are paused.
+	PausedKey = collections.NewPrefix(15)
+
+	// FeeRecipientsKey saves the accrued and paid fees of the fee distribution by account address.
+	FeeRecipientsKey = collections.NewPrefix(16)
+
+	// BondedTokensKey tracks the tokens delegated to the POA validators, which the bonded pool must hold.
+	BondedTokensKey = collections.NewPrefix(17)
+
+	// BannedValidatorsKey saves the operator addresses of the validators removed for double signing.
+	BannedValidatorsKey = collections.NewPrefix(18)
+
+	// BannedConsensusAddressesKey saves the consensus addresses of the validators removed for double signing.
+	BannedConsensusAddressesKey = collections.NewPrefix(19)
+
+	// SlashedValidatorsKey tracks the validators slashed by x/staking, checked for a tombstone in the next begin block.
+	SlashedValidatorsKey = collections.NewPrefix(20)
+
+	// OrganizationPowerKey tracks the combined consensus power of the validators of each organization.
+	OrganizationPowerKey = collections.NewPrefix(21)
+
+	// ModifiedDelegationsKey holds a delegation x/staking is modifying in hybrid mode as it was before the change,
+	// between the before and after delegation hooks. It is empty outside of a delegation.
+	ModifiedDelegationsKey = collections.NewPrefix(22)
+
+	// AuthoritiesKey holds the admin and the guardian the audit log last recorded.
+	AuthoritiesKey = collections.NewPrefix(23)
 )
 
 const (
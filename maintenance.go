@@ -0,0 +1,45 @@
+package poa
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Validate checks the expiry height and the allowed message type URLs. A disabled maintenance mode has neither.
+func (m MaintenanceMode) Validate() error {
+	if !m.Enabled {
+		if m.ExpiryHeight != 0 || len(m.AllowedMsgTypeUrls) > 0 {
+			return fmt.Errorf("disabled maintenance mode can not have an expiry height or allowed message types")
+		}
+
+		return nil
+	}
+
+	if m.ExpiryHeight < 0 {
+		return fmt.Errorf("maintenance mode expiry height can not be negative: %d", m.ExpiryHeight)
+	}
+
+	seen := make(map[string]bool, len(m.AllowedMsgTypeUrls))
+	for _, typeURL := range m.AllowedMsgTypeUrls {
+		if !isValidMsgTypeURL(typeURL) {
+			return fmt.Errorf("invalid allowed message type url %q", typeURL)
+		}
+
+		if seen[typeURL] {
+			return fmt.Errorf("duplicate allowed message type url %s", typeURL)
+		}
+		seen[typeURL] = true
+	}
+
+	return nil
+}
+
+// IsActive returns true if maintenance mode is enabled and has not expired at the height.
+func (m MaintenanceMode) IsActive(height int64) bool {
+	return m.Enabled && (m.ExpiryHeight == 0 || height < m.ExpiryHeight)
+}
+
+// IsMsgAllowed returns true if the message type is accepted from any account during maintenance mode.
+func (m MaintenanceMode) IsMsgAllowed(typeURL string) bool {
+	return slices.Contains(m.AllowedMsgTypeUrls, typeURL)
+}
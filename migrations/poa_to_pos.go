@@ -0,0 +1,302 @@
+package migrations
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// PoSOptions configures the stake backing the validators after a PoA to PoS migration. Either a funding source or
+// KeepMintedStake must be set.
+type PoSOptions struct {
+	// FundingSource funds the validator self delegations with real tokens. The POA minted tokens of the bonded self
+	// delegations are burned and replaced by a transfer from this account, which must hold at least these tokens.
+	FundingSource sdk.AccAddress
+	// KeepMintedStake keeps the POA minted tokens as the validators' own stake when there is no funding source, leaving
+	// them in the total supply.
+	KeepMintedStake bool
+	// Minter is the module account minting a shortfall of the staking pools, it must have the Minter permission in the
+	// app running the migration. The POA module account has none once POA is removed. Defaults to x/mint.
+	Minter string
+}
+
+// MigrateToPoS turns the POA managed x/staking state back into a valid PoS state. It is meant to be called from the
+// upgrade handler of the release which removes the POA module. It:
+// - rebuilds the validator power index, dropping the stale entries POA power changes leave behind
+// - removes the zero share delegations of validators removed by POA
+// - reconciles the bonded and not bonded pools with the staked tokens
+// - with a funding source, burns the POA minted tokens of the bonded self delegations and funds them with real tokens
+// from the funding source instead, the delegations of token holders in hybrid mode are already backed by real tokens
+// - initializes the x/distribution state of every delegation through the staking hooks
+// - checks the x/staking invariants
+//
+// Without a funding source the POA minted tokens can only be kept as the validators' own stake, burning them would
+// leave the set without stake. This must be chosen explicitly with KeepMintedStake.
+func MigrateToPoS(ctx context.Context, bk BankKeeper, sk *stakingkeeper.Keeper, opts PoSOptions) error {
+	if opts.FundingSource.Empty() && !opts.KeepMintedStake {
+		return errors.New("a funding source is required to replace the POA minted stake, or the minted stake must be kept explicitly")
+	}
+
+	minter := opts.Minter
+	if minter == "" {
+		minter = minttypes.ModuleName
+	}
+
+	bondDenom, err := sk.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := rebuildPowerIndex(ctx, sk); err != nil {
+		return err
+	}
+
+	if err := removeEmptyDelegations(ctx, sk); err != nil {
+		return err
+	}
+
+	bondedTokens, notBondedTokens, err := stakedTokens(ctx, sk)
+	if err != nil {
+		return err
+	}
+
+	if fundingSource := opts.FundingSource; !fundingSource.Empty() {
+		// only the POA self delegations were minted, so they are replaced by real tokens from the funding source.
+		selfTokens, err := bondedSelfDelegationTokens(ctx, sk)
+		if err != nil {
+			return err
+		}
+
+		// a shortfall of the pool is minted again when it is reconciled.
+		bonded := bk.GetBalance(ctx, sk.GetBondedPool(ctx).GetAddress(), bondDenom).Amount
+		if burn := sdkmath.MinInt(bonded, selfTokens); burn.IsPositive() {
+			if err := bk.BurnCoins(ctx, stakingtypes.BondedPoolName, sdk.NewCoins(sdk.NewCoin(bondDenom, burn))); err != nil {
+				return err
+			}
+		}
+
+		if selfTokens.IsPositive() {
+			funds := sdk.NewCoins(sdk.NewCoin(bondDenom, selfTokens))
+			if err := bk.SendCoinsFromAccountToModule(ctx, fundingSource, stakingtypes.BondedPoolName, funds); err != nil {
+				return fmt.Errorf("funding source %s can not fund the validator self delegations: %w", fundingSource, err)
+			}
+		}
+	}
+
+	if err := reconcilePool(ctx, bk, minter, stakingtypes.BondedPoolName, sk.GetBondedPool(ctx).GetAddress(), bondDenom, bondedTokens); err != nil {
+		return err
+	}
+
+	if err := reconcilePool(ctx, bk, minter, stakingtypes.NotBondedPoolName, sk.GetNotBondedPool(ctx).GetAddress(), bondDenom, notBondedTokens); err != nil {
+		return err
+	}
+
+	if err := initializeDelegations(ctx, sk); err != nil {
+		return err
+	}
+
+	if msg, broken := stakingkeeper.AllInvariants(sk)(sdk.UnwrapSDKContext(ctx)); broken {
+		return fmt.Errorf("x/staking invariants are broken after the migration: %s", msg)
+	}
+
+	return nil
+}
+
+// rebuildPowerIndex removes every entry of the x/staking validator power index and indexes each unjailed validator
+// once by its current tokens. POA sets a new entry on every power change, which leaves the previous one in place.
+func rebuildPowerIndex(ctx context.Context, sk *stakingkeeper.Keeper) error {
+	type indexEntry struct {
+		valAddr sdk.ValAddress
+		power   int64
+	}
+
+	iter, err := sk.ValidatorsPowerStoreIterator(ctx)
+	if err != nil {
+		return err
+	}
+
+	var entries []indexEntry
+	for ; iter.Valid(); iter.Next() {
+		// key is of format prefix (1 byte) || powerbytes (8 bytes) || addrLen (1byte) || addrBytes
+		key := iter.Key()
+		entries = append(entries, indexEntry{
+			valAddr: stakingtypes.ParseValidatorPowerRankKey(key),
+			power:   int64(binary.BigEndian.Uint64(key[1:9])),
+		})
+	}
+
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		val, err := sk.GetValidator(ctx, entry.valAddr)
+		if err != nil {
+			return err
+		}
+
+		// the index key is derived from the tokens, so the stale entry is deleted with the power it was set with.
+		val.Tokens = sk.TokensFromConsensusPower(ctx, entry.power)
+		if err := sk.DeleteValidatorByPowerIndex(ctx, val); err != nil {
+			return err
+		}
+	}
+
+	vals, err := sk.GetAllValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, val := range vals {
+		if val.Jailed {
+			continue
+		}
+
+		if err := sk.SetValidatorByPowerIndex(ctx, val); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeEmptyDelegations removes the delegations without shares which POA leaves for removed validators.
+func removeEmptyDelegations(ctx context.Context, sk *stakingkeeper.Keeper) error {
+	dels, err := sk.GetAllDelegations(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, del := range dels {
+		if del.Shares.IsPositive() {
+			continue
+		}
+
+		if err := sk.RemoveDelegation(ctx, del); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stakedTokens returns the tokens the bonded and not bonded pools must hold.
+func stakedTokens(ctx context.Context, sk *stakingkeeper.Keeper) (bonded, notBonded sdkmath.Int, err error) {
+	bonded, notBonded = sdkmath.ZeroInt(), sdkmath.ZeroInt()
+
+	vals, err := sk.GetAllValidators(ctx)
+	if err != nil {
+		return bonded, notBonded, err
+	}
+
+	for _, val := range vals {
+		if val.IsBonded() {
+			bonded = bonded.Add(val.Tokens)
+		} else {
+			notBonded = notBonded.Add(val.Tokens)
+		}
+	}
+
+	err = sk.IterateUnbondingDelegations(ctx, func(_ int64, ubd stakingtypes.UnbondingDelegation) bool {
+		for _, entry := range ubd.Entries {
+			notBonded = notBonded.Add(entry.Balance)
+		}
+		return false
+	})
+
+	return bonded, notBonded, err
+}
+
+// bondedSelfDelegationTokens returns the tokens of the self delegations of the bonded validators, which POA sets for
+// the power assigned by the admin.
+func bondedSelfDelegationTokens(ctx context.Context, sk *stakingkeeper.Keeper) (sdkmath.Int, error) {
+	tokens := sdkmath.ZeroInt()
+
+	vals, err := sk.GetAllValidators(ctx)
+	if err != nil {
+		return tokens, err
+	}
+
+	for _, val := range vals {
+		if !val.IsBonded() || val.DelegatorShares.IsZero() {
+			continue
+		}
+
+		valAddr, err := sk.ValidatorAddressCodec().StringToBytes(val.OperatorAddress)
+		if err != nil {
+			return tokens, err
+		}
+
+		del, err := sk.GetDelegation(ctx, sdk.AccAddress(valAddr), valAddr)
+		if errors.Is(err, stakingtypes.ErrNoDelegation) {
+			continue
+		} else if err != nil {
+			return tokens, err
+		}
+
+		tokens = tokens.Add(val.TokensFromShares(del.Shares).TruncateInt())
+	}
+
+	return tokens, nil
+}
+
+// reconcilePool sets the balance of a staking pool to the staked amount. A surplus of minted tokens is burned, and a
+// shortfall, left by POA slashing removed validators, is minted by the minter module.
+func reconcilePool(ctx context.Context, bk BankKeeper, minter, pool string, poolAddr sdk.AccAddress, bondDenom string, staked sdkmath.Int) error {
+	balance := bk.GetBalance(ctx, poolAddr, bondDenom).Amount
+
+	switch {
+	case balance.GT(staked):
+		return bk.BurnCoins(ctx, pool, sdk.NewCoins(sdk.NewCoin(bondDenom, balance.Sub(staked))))
+	case balance.LT(staked):
+		coins := sdk.NewCoins(sdk.NewCoin(bondDenom, staked.Sub(balance)))
+		if err := bk.MintCoins(ctx, minter, coins); err != nil {
+			return err
+		}
+
+		return bk.SendCoinsFromModuleToModule(ctx, minter, pool, coins)
+	default:
+		return nil
+	}
+}
+
+// initializeDelegations runs the staking delegation hooks for every delegation, since POA sets delegations without
+// them. This withdraws any pending rewards and starts the x/distribution reward tracking from the current stake.
+func initializeDelegations(ctx context.Context, sk *stakingkeeper.Keeper) error {
+	dels, err := sk.GetAllDelegations(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, del := range dels {
+		delAddr, err := sdk.AccAddressFromBech32(del.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+
+		valAddr, err := sk.ValidatorAddressCodec().StringToBytes(del.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		// delegations POA set have no reward tracking yet, there is nothing to withdraw for them.
+		if err := sk.Hooks().BeforeDelegationSharesModified(ctx, delAddr, valAddr); err != nil && !errors.Is(err, distrtypes.ErrEmptyDelegationDistInfo) {
+			return err
+		}
+
+		if err := sk.Hooks().AfterDelegationModified(ctx, delAddr, valAddr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,313 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/strangelove-ventures/poa"
+	poakeeper "github.com/strangelove-ventures/poa/keeper"
+)
+
+// farFuture is used to dequeue every pending unbonding and redelegation entry, regardless of its completion time.
+var farFuture = time.Unix(1<<62, 0)
+
+type BankKeeper interface {
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	UndelegateCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// Keepers are the keepers a PoS to PoA migration operates on.
+type Keepers struct {
+	BankKeeper    BankKeeper
+	StakingKeeper *stakingkeeper.Keeper
+	POAKeeper     poakeeper.Keeper
+}
+
+// PowerPolicy returns the POA power (10^6 precision, 1_000_000 = 1 power) a bonded PoS validator keeps after the
+// migration. A power of 0 removes the validator from the set.
+type PowerPolicy func(ctx context.Context, val stakingtypes.Validator) (uint64, error)
+
+// EqualPower gives every bonded validator the same power.
+func EqualPower(power uint64) PowerPolicy {
+	return func(_ context.Context, _ stakingtypes.Validator) (uint64, error) {
+		return power, nil
+	}
+}
+
+// TokenPower keeps the current consensus power of every bonded validator, including delegations.
+func TokenPower(sk *stakingkeeper.Keeper) PowerPolicy {
+	return func(ctx context.Context, val stakingtypes.Validator) (uint64, error) {
+		power := val.ConsensusPower(sk.PowerReduction(ctx))
+		return sk.TokensFromConsensusPower(ctx, power).Uint64(), nil
+	}
+}
+
+// ExplicitPower sets the power of the listed validator operator addresses. Validators not listed are removed.
+func ExplicitPower(powers map[string]uint64) PowerPolicy {
+	return func(_ context.Context, val stakingtypes.Validator) (uint64, error) {
+		return powers[val.OperatorAddress], nil
+	}
+}
+
+// MigrateFromPoS converts the x/staking state of a PoS chain into a POA validator set. It is meant to be called from
+// an upgrade handler which adds the POA store, next to running the module migrations which initialize its genesis. It:
+// - refunds and cancels all pending unbonding delegations and removes all redelegations
+// - force undelegates every delegation, refunding the tokens to the delegators
+// - gives every bonded validator a single self delegation of the power set by the policy
+// - removes validators which are not bonded or get no power
+// - mints the new POA power into the bonded pool
+//
+// The validator set updates are returned by x/staking at the end of the upgrade block, and the POA power caches
+// pick up the new total power from the next block on. The admin must be the configured POA authority so the chain
+// is left with a working administrator.
+func MigrateFromPoS(ctx context.Context, keepers Keepers, admin string, policy PowerPolicy) error {
+	sk := keepers.StakingKeeper
+	k := keepers.POAKeeper
+
+	if !k.IsAdmin(ctx, admin) {
+		return fmt.Errorf("%s is not the POA authority: %w", admin, poa.ErrNotAnAuthority)
+	}
+
+	bondDenom, err := sk.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := cancelUnbondingDelegations(ctx, keepers, bondDenom); err != nil {
+		return err
+	}
+
+	if err := cancelRedelegations(ctx, sk); err != nil {
+		return err
+	}
+
+	vals, err := sk.GetAllValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	var totalPower int64
+	for _, val := range vals {
+		power := uint64(0)
+		if val.IsBonded() && !val.Jailed {
+			if power, err = policy(ctx, val); err != nil {
+				return err
+			}
+		}
+
+		if power != 0 && power < sk.PowerReduction(ctx).Uint64() {
+			return fmt.Errorf("validator %s: %w", val.OperatorAddress, poa.ErrPowerBelowMinimum)
+		}
+
+		if err := collapseValidator(ctx, keepers, val, power, bondDenom); err != nil {
+			return fmt.Errorf("failed to migrate validator %s: %w", val.OperatorAddress, err)
+		}
+
+		totalPower += sk.TokensToConsensusPower(ctx, sdkmath.NewIntFromUint64(power))
+	}
+
+	if totalPower == 0 {
+		return fmt.Errorf("no validator has power after the migration")
+	}
+
+	// the previous bonded tokens were refunded, so the bonded pool only has to back the new POA power.
+	if _, err := k.ReconcileBondedTokens(ctx); err != nil {
+		return err
+	}
+
+	if err := k.UpdateBondedPoolPower(ctx); err != nil {
+		return err
+	}
+
+	k.Logger().Info("migrated from PoS to PoA", "validators", len(vals), "total_power", totalPower)
+
+	return nil
+}
+
+// cancelUnbondingDelegations refunds every pending unbonding delegation to its delegator and clears the unbonding queue.
+func cancelUnbondingDelegations(ctx context.Context, keepers Keepers, bondDenom string) error {
+	sk := keepers.StakingKeeper
+
+	var ubds []stakingtypes.UnbondingDelegation
+	if err := sk.IterateUnbondingDelegations(ctx, func(_ int64, ubd stakingtypes.UnbondingDelegation) bool {
+		ubds = append(ubds, ubd)
+		return false
+	}); err != nil {
+		return err
+	}
+
+	for _, ubd := range ubds {
+		delAddr, err := sdk.AccAddressFromBech32(ubd.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+
+		balance := sdkmath.ZeroInt()
+		for _, entry := range ubd.Entries {
+			balance = balance.Add(entry.Balance)
+
+			if err := sk.DeleteUnbondingIndex(ctx, entry.UnbondingId); err != nil {
+				return err
+			}
+		}
+
+		// unbonding tokens are always held by the not bonded pool.
+		if balance.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(bondDenom, balance))
+			if err := keepers.BankKeeper.UndelegateCoinsFromModuleToAccount(ctx, stakingtypes.NotBondedPoolName, delAddr, coins); err != nil {
+				return err
+			}
+		}
+
+		if err := sk.RemoveUnbondingDelegation(ctx, ubd); err != nil {
+			return err
+		}
+	}
+
+	_, err := sk.DequeueAllMatureUBDQueue(ctx, farFuture)
+	return err
+}
+
+// cancelRedelegations removes every redelegation and clears the redelegation queue. The redelegated tokens are
+// already delegated to the destination validator, so they are refunded with the delegations.
+func cancelRedelegations(ctx context.Context, sk *stakingkeeper.Keeper) error {
+	var reds []stakingtypes.Redelegation
+	if err := sk.IterateRedelegations(ctx, func(_ int64, red stakingtypes.Redelegation) bool {
+		reds = append(reds, red)
+		return false
+	}); err != nil {
+		return err
+	}
+
+	for _, red := range reds {
+		for _, entry := range red.Entries {
+			if err := sk.DeleteUnbondingIndex(ctx, entry.UnbondingId); err != nil {
+				return err
+			}
+		}
+
+		if err := sk.RemoveRedelegation(ctx, red); err != nil {
+			return err
+		}
+	}
+
+	_, err := sk.DequeueAllMatureRedelegationQueue(ctx, farFuture)
+	return err
+}
+
+// collapseValidator undelegates all delegations of a validator, refunding the delegators. If the validator keeps
+// power, it is given a single self delegation of that power. Otherwise unbonded validators are removed, and bonded
+// ones leave the set at the end of the block.
+func collapseValidator(ctx context.Context, keepers Keepers, val stakingtypes.Validator, power uint64, bondDenom string) error {
+	sk := keepers.StakingKeeper
+
+	valAddr, err := sk.ValidatorAddressCodec().StringToBytes(val.OperatorAddress)
+	if err != nil {
+		return err
+	}
+
+	// an unbonding validator completes its unbonding right away.
+	if val.IsUnbonding() {
+		if err := sk.DeleteValidatorQueue(ctx, val); err != nil {
+			return err
+		}
+
+		for _, id := range val.UnbondingIds {
+			if err := sk.DeleteUnbondingIndex(ctx, id); err != nil {
+				return err
+			}
+		}
+		val.UnbondingIds = nil
+
+		if val, err = sk.UnbondingToUnbonded(ctx, val); err != nil {
+			return err
+		}
+	}
+
+	// the operator is not jailed when its self delegation is removed.
+	val.MinSelfDelegation = sdkmath.ZeroInt()
+	if err := sk.SetValidator(ctx, val); err != nil {
+		return err
+	}
+
+	pool := stakingtypes.NotBondedPoolName
+	if val.IsBonded() {
+		pool = stakingtypes.BondedPoolName
+	}
+
+	dels, err := sk.GetValidatorDelegations(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	for _, del := range dels {
+		delAddr, err := sdk.AccAddressFromBech32(del.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+
+		amt, err := sk.Unbond(ctx, delAddr, valAddr, del.Shares)
+		if err != nil {
+			return err
+		}
+
+		if amt.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(bondDenom, amt))
+			if err := keepers.BankKeeper.UndelegateCoinsFromModuleToAccount(ctx, pool, delAddr, coins); err != nil {
+				return err
+			}
+		}
+	}
+
+	if power == 0 {
+		if val.IsUnbonded() {
+			return sk.RemoveValidator(ctx, valAddr)
+		}
+
+		return nil
+	}
+
+	val, err = sk.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := sk.DeleteValidatorByPowerIndex(ctx, val); err != nil {
+		return err
+	}
+
+	amt := sdkmath.NewIntFromUint64(power)
+	val.Tokens = amt
+	val.DelegatorShares = sdkmath.LegacyNewDecFromInt(amt)
+	val.MinSelfDelegation = sdkmath.OneInt()
+
+	if err := sk.SetValidator(ctx, val); err != nil {
+		return err
+	}
+
+	if err := sk.SetValidatorByPowerIndex(ctx, val); err != nil {
+		return err
+	}
+
+	delAddr := sdk.AccAddress(valAddr)
+	if err := sk.Hooks().BeforeDelegationCreated(ctx, delAddr, valAddr); err != nil {
+		return err
+	}
+
+	if err := sk.SetDelegation(ctx, stakingtypes.NewDelegation(delAddr.String(), val.OperatorAddress, val.DelegatorShares)); err != nil {
+		return err
+	}
+
+	return sk.Hooks().AfterDelegationModified(ctx, delAddr, valAddr)
+}
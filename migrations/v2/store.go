@@ -0,0 +1,73 @@
+package v2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+
+	"github.com/strangelove-ventures/poa"
+)
+
+// PendingValidatorsKey is the consensus version 1 key of the pending validators, saved as a single list.
+var PendingValidatorsKey = collections.NewPrefix(1)
+
+// MigrateStore performs in-place store migrations from consensus version 1 to 2. The migration includes:
+// - setting the module params, keeping the allow_validator_self_exit value of a params item left by older releases
+// - re-keying the pending validators from a single list into a map by operator address
+func MigrateStore(ctx context.Context, storeService storetypes.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	params := collections.NewItem(sb, poa.ParamsKey, "params", codec.CollValue[poa.Params](cdc))
+	legacyPending := collections.NewItem(sb, PendingValidatorsKey, "pending", codec.CollValue[poa.Validators](cdc))
+	pending := collections.NewMap(sb, poa.PendingValidatorsKey, "pending_validators", collections.StringKey, codec.CollValue[poa.Validator](cdc))
+
+	if err := migrateParams(ctx, params); err != nil {
+		return err
+	}
+
+	return migratePendingValidators(ctx, legacyPending, pending)
+}
+
+// migrateParams sets the default params, keeping the allow_validator_self_exit value of a params item kept from
+// before the admins were moved to the keeper authority. Its deprecated admins field is dropped.
+func migrateParams(ctx context.Context, params collections.Item[poa.Params]) error {
+	p := poa.DefaultParams()
+
+	if has, err := params.Has(ctx); err != nil {
+		return err
+	} else if has {
+		legacy, err := params.Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		p.AllowValidatorSelfExit = legacy.AllowValidatorSelfExit
+	}
+
+	return params.Set(ctx, p)
+}
+
+func migratePendingValidators(
+	ctx context.Context,
+	legacyPending collections.Item[poa.Validators],
+	pending collections.Map[string, poa.Validator],
+) error {
+	vals, err := legacyPending.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	for _, val := range vals.Validators {
+		if err := pending.Set(ctx, val.OperatorAddress, val); err != nil {
+			return err
+		}
+	}
+
+	return legacyPending.Remove(ctx)
+}
@@ -51,6 +51,14 @@ func (am AppModule) BeginBlocker(ctx context.Context) error {
 		}
 	}
 
+	if err := am.keeper.AuditAuthorityChange(ctx); err != nil {
+		return err
+	}
+
+	if err := am.keeper.ExpireMaintenanceMode(ctx); err != nil {
+		return err
+	}
+
 	// reset caches
 	if sdkCtx.BlockHeight() > 1 {
 		// non gentx messages reset the cached block powers for IBC validations.
@@ -63,6 +71,38 @@ func (am AppModule) BeginBlocker(ctx context.Context) error {
 		}
 	}
 
+	// the organization powers pick up the power changes x/staking applied at the end of the last block.
+	if err := am.keeper.ReconcileOrganizationPower(ctx); err != nil {
+		return err
+	}
+
+	if err := am.keeper.ReconcileBondedTokensPeriodically(ctx); err != nil {
+		return err
+	}
+
+	// the validators tombstoned by x/evidence in this block are removed before the power changes of the block.
+	if err := am.keeper.HandleTombstonedValidators(ctx); err != nil {
+		return err
+	}
+
+	// the downtime policy and the equal power rebalance change the validator set, they are skipped while paused.
+	paused, err := am.keeper.IsPaused(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !paused {
+		// the downtime policy reads the missed blocks x/slashing updated for the last commit.
+		if err := am.keeper.ApplyDowntimePolicy(ctx); err != nil {
+			return err
+		}
+
+		// the rebalance is applied by x/staking with the other power updates of the block.
+		if err := am.keeper.RebalanceEqualPower(ctx); err != nil {
+			return err
+		}
+	}
+
 	// Event Debugging
 	events, err := am.keeper.GetStakingKeeper().GetValidatorUpdates(ctx)
 	if err != nil {
@@ -81,3 +121,10 @@ func (am AppModule) BeginBlocker(ctx context.Context) error {
 
 	return nil
 }
+
+// EndBlocker pays the fees of the block to the validators before x/distribution allocates them in the next begin block.
+func (am AppModule) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(poa.ModuleName, sdk.UnwrapSDKContext(ctx).BlockTime(), telemetry.MetricKeyEndBlocker)
+
+	return am.keeper.DistributeFees(ctx)
+}
@@ -10,7 +10,8 @@ import (
 func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
 		Query: &autocliv1.ServiceCommandDescriptor{
-			Service: poav1.Query_ServiceDesc.ServiceName,
+			Service:              poav1.Query_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
 					RpcMethod: "ConsensusPower",
@@ -20,6 +21,57 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "Organizations",
+					Use:       "organizations",
+					Short:     "Get all registered validator organizations",
+				},
+				{
+					RpcMethod: "OrganizationPower",
+					Use:       "organization-power [organization-id]",
+					Short:     "Get the validators and combined consensus power of an organization",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "organization_id"},
+					},
+				},
+				{
+					RpcMethod: "AccountAllowlist",
+					Use:       "account-allowlist",
+					Short:     "Get the accounts and address prefixes allowed to submit transactions",
+				},
+				{
+					RpcMethod: "MaintenanceMode",
+					Use:       "maintenance-mode",
+					Short:     "Get the maintenance mode and whether it is active",
+				},
+				{
+					RpcMethod: "Status",
+					Use:       "status",
+					Short:     "Get whether the validator set operations are paused, and the guardian",
+				},
+				{
+					RpcMethod: "FeeRecipient",
+					Use:       "fee-recipient [address]",
+					Short:     "Get the fees accrued and paid to an account by the fee distribution",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "FeeRecipients",
+					Use:       "fee-recipients",
+					Short:     "Get the fees accrued and paid to all the accounts by the fee distribution",
+				},
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Get the POA module parameters",
+				},
+				{
+					// implemented in the custom query command for csv output.
+					RpcMethod: "AuditLog",
+					Skip:      true,
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
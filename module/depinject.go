@@ -1,6 +1,7 @@
 package module
 
 import (
+	"context"
 	"os"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -9,6 +10,7 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
@@ -46,7 +48,7 @@ type ModuleInputs struct {
 	AddressCodec address.Codec
 
 	StakingKeeper  keeper.StakingKeeper
-	SlashingKeeper keeper.SlashingKeeper
+	SlashingKeeper keeper.SlashingKeeper `optional:"true"` // nil on chains without x/slashing
 	BankKeeper     keeper.BankKeeper
 	AccountKeeper  keeper.AccountKeeper // for testing
 }
@@ -54,8 +56,9 @@ type ModuleInputs struct {
 type ModuleOutputs struct {
 	depinject.Out
 
-	Module appmodule.AppModule
-	Keeper keeper.Keeper
+	Module       appmodule.AppModule
+	Keeper       keeper.Keeper
+	StakingHooks stakingtypes.StakingHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -66,9 +69,18 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	k := keeper.NewKeeper(in.Cdc, in.StoreService, in.StakingKeeper, in.SlashingKeeper, in.BankKeeper, log.NewLogger(os.Stderr), authority.String())
 	k.SetTestAccountKeeper(in.AccountKeeper) // for testing
+
+	if in.Config.Guardian != "" {
+		guardian := sdk.MustAccAddressFromBech32(in.Config.Guardian)
+		if guardian.String() == k.GetAdmin(context.Background()) {
+			panic("poa guardian must be different from the authority")
+		}
+
+		k.SetGuardian(guardian.String())
+	}
 	m := NewAppModule(in.Cdc, k)
 
-	return ModuleOutputs{Module: m, Keeper: k, Out: depinject.Out{}}
+	return ModuleOutputs{Module: m, Keeper: k, StakingHooks: stakingtypes.StakingHooksWrapper{StakingHooks: k.Hooks()}, Out: depinject.Out{}}
 }
 
 // AppModuleSimulation functions
@@ -27,10 +27,12 @@ var (
 	_ module.AppModule          = AppModule{}
 	_ module.AppModuleGenesis   = AppModule{}
 	_ appmodule.HasBeginBlocker = AppModule{}
+	_ appmodule.HasEndBlocker   = AppModule{}
+	_ module.HasInvariants      = AppModule{}
 )
 
 // ConsensusVersion defines the current module consensus version.
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 type AppModule struct {
 	cdc    codec.Codec
@@ -64,6 +66,11 @@ func (AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwrunt
 	}
 }
 
+// GetQueryCmd returns the custom query commands for the poa module. The remaining query commands are generated by autocli.
+func (am AppModule) GetQueryCmd() *cobra.Command {
+	return cli.NewQueryCmd()
+}
+
 // GetTxCmd returns the root tx command for the poa module.
 func (am AppModule) GetTxCmd() *cobra.Command {
 	return cli.NewTxCmd(am.cdc.InterfaceRegistry().SigningContext().ValidatorAddressCodec())
@@ -83,10 +90,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	poa.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
 
 	// Register in place module state migration migrations
-	// m := keeper.NewMigrator(am.keeper)
-	// if err := cfg.RegisterMigration(poa.ModuleName, 1, m.Migrate1to2); err != nil {
-	// 	panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", poa.ModuleName, err))
-	// }
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(poa.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", poa.ModuleName, err))
+	}
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the module.
@@ -105,7 +112,7 @@ func (AppModule) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig,
 }
 
 // InitGenesis performs genesis initialization for the poa module.
-// It returns no validator updates.
+// It returns the validator updates of the genesis validators, if any.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState poa.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
@@ -114,11 +121,19 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 		panic(err)
 	}
 
-	if err := am.keeper.InitCacheStores(ctx); err != nil {
+	updates, err := am.keeper.InitGenesisValidators(ctx, genesisState.Validators)
+	if err != nil {
 		panic(err)
 	}
 
-	return nil
+	// A new chain starts its power caches from the x/staking genesis power.
+	if genesisState.CachedBlockPower == 0 {
+		if err := am.keeper.InitCacheStores(ctx); err != nil {
+			panic(err)
+		}
+	}
+
+	return updates
 }
 
 // ExportGenesis returns the exported genesis state as raw bytes for the circuit
@@ -132,3 +147,12 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 func (am AppModule) BeginBlock(ctx context.Context) error {
 	return am.BeginBlocker(ctx)
 }
+
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.EndBlocker(ctx)
+}
+
+// RegisterInvariants registers the invariants of the poa module.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
@@ -1,8 +1,11 @@
 package poa
 
 import (
+	"strings"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"cosmossdk.io/math"
 )
@@ -12,6 +15,42 @@ var (
 	_ codectypes.UnpackInterfacesMessage = (*Validator)(nil)
 )
 
+// IsPOAMsgTypeURL returns true if the type URL is the one of a POA message.
+func IsPOAMsgTypeURL(typeURL string) bool {
+	return strings.HasPrefix(typeURL, strings.TrimSuffix(sdk.MsgTypeURL(&MsgUpdateParams{}), "MsgUpdateParams"))
+}
+
+// AdminMsgSender returns the sender of the POA messages which may be sent by the admin.
+func AdminMsgSender(msg sdk.Msg) (string, bool) {
+	switch msg := msg.(type) {
+	case *MsgSetPower:
+		return msg.Sender, true
+	case *MsgRemoveValidator:
+		return msg.Sender, true
+	case *MsgRemovePending:
+		return msg.Sender, true
+	case *MsgUpdateStakingParams:
+		return msg.Sender, true
+	case *MsgUpdateSlashingParams:
+		return msg.Sender, true
+	case *MsgUpdateParams:
+		return msg.Sender, true
+	case *MsgRegisterOrganization:
+		return msg.Sender, true
+	case *MsgSetValidatorOrganization:
+		return msg.Sender, true
+	case *MsgUpdateAccountAllowlist:
+		return msg.Sender, true
+	case *MsgSetMaintenanceMode:
+		return msg.Sender, true
+	// also signed by the guardian.
+	case *MsgUnpause:
+		return msg.Authority, true
+	default:
+		return "", false
+	}
+}
+
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
 func NewMsgCreateValidator(
 	valAddr string, pubKey cryptotypes.PubKey, description Description, commission CommissionRates, minSelfDelegation math.Int,
@@ -0,0 +1,52 @@
+package poa
+
+import (
+	"regexp"
+
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// MaxOrganizationIDLength is the maximum length of an organization id.
+const MaxOrganizationIDLength = 64
+
+var organizationIDRegex = regexp.MustCompile(`^[a-zA-Z0-9_\-.]+$`)
+
+// DefaultMaxOrganizationPowerShare is the suggested maximum share of power (1/3) for an organization.
+var DefaultMaxOrganizationPowerShare = math.LegacyOneDec().QuoInt64(3)
+
+// NewOrganization creates a new POA Organization instance.
+func NewOrganization(id, name string, maxPowerShare math.LegacyDec) Organization {
+	return Organization{
+		Id:            id,
+		Name:          name,
+		MaxPowerShare: maxPowerShare,
+	}
+}
+
+// Validate performs basic validation of the organization.
+func (o Organization) Validate() error {
+	if o.Id == "" {
+		return errorsmod.Wrap(ErrInvalidOrganization, "empty id")
+	}
+
+	if len(o.Id) > MaxOrganizationIDLength {
+		return errorsmod.Wrapf(ErrInvalidOrganization, "invalid id length; got: %d, max: %d", len(o.Id), MaxOrganizationIDLength)
+	}
+
+	if !organizationIDRegex.MatchString(o.Id) {
+		return errorsmod.Wrapf(ErrInvalidOrganization, "id %s must only contain alphanumeric characters, '_', '-' or '.'", o.Id)
+	}
+
+	if len(o.Name) > types.MaxMonikerLength {
+		return errorsmod.Wrapf(ErrInvalidOrganization, "invalid name length; got: %d, max: %d", len(o.Name), types.MaxMonikerLength)
+	}
+
+	if o.MaxPowerShare.IsNil() || !o.MaxPowerShare.IsPositive() || o.MaxPowerShare.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidOrganization, "max power share must be within (0, 1], got: %s", o.MaxPowerShare)
+	}
+
+	return nil
+}
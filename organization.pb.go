@@ -0,0 +1,464 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: strangelove_ventures/poa/v1/organization.proto
+
+package poa
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Organization groups validators operated by the same institution so their
+// combined power can be limited.
+type Organization struct {
+	// id is the unique identifier of the organization.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the human-readable name of the organization.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// max_power_share is the maximum fraction of the total consensus power the
+	// organization's validators may hold together.
+	MaxPowerShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_power_share,json=maxPowerShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_power_share"`
+}
+
+func (m *Organization) Reset()         { *m = Organization{} }
+func (m *Organization) String() string { return proto.CompactTextString(m) }
+func (*Organization) ProtoMessage()    {}
+func (*Organization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c4ba3961eda7f53, []int{0}
+}
+func (m *Organization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Organization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Organization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Organization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Organization.Merge(m, src)
+}
+func (m *Organization) XXX_Size() int {
+	return m.Size()
+}
+func (m *Organization) XXX_DiscardUnknown() {
+	xxx_messageInfo_Organization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Organization proto.InternalMessageInfo
+
+func (m *Organization) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Organization) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Organization)(nil), "strangelove_ventures.poa.v1.Organization")
+}
+
+func init() {
+	proto.RegisterFile("strangelove_ventures/poa/v1/organization.proto", fileDescriptor_5c4ba3961eda7f53)
+}
+
+var fileDescriptor_5c4ba3961eda7f53 = []byte{
+	// 316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x3f, 0x4b, 0x3b, 0x31,
+	0x1c, 0xc6, 0x2f, 0xfd, 0xfd, 0x10, 0x3c, 0xfc, 0x7b, 0x38, 0xf4, 0x0f, 0x5c, 0x45, 0x17, 0x29,
+	0x34, 0xa1, 0x08, 0x0e, 0x82, 0x4b, 0xe9, 0x28, 0x28, 0x75, 0x73, 0xf0, 0xf8, 0xf6, 0x1a, 0xae,
+	0x41, 0x93, 0xef, 0x91, 0xa4, 0x67, 0xf5, 0x25, 0x38, 0xf9, 0x12, 0x1c, 0x5d, 0x84, 0x0e, 0xbe,
+	0x88, 0x8e, 0xc5, 0x49, 0x1c, 0x8a, 0xb4, 0x43, 0x7d, 0x19, 0x72, 0x77, 0x1d, 0x6e, 0x70, 0x09,
+	0xdf, 0x7c, 0xf2, 0xe4, 0xc9, 0x93, 0xc7, 0xa5, 0xc6, 0x6a, 0x50, 0x11, 0xbf, 0xc3, 0x84, 0x07,
+	0x09, 0x57, 0x76, 0xa8, 0xb9, 0x61, 0x31, 0x02, 0x4b, 0x5a, 0x0c, 0x75, 0x04, 0x4a, 0x3c, 0x82,
+	0x15, 0xa8, 0x68, 0xac, 0xd1, 0xa2, 0x57, 0xfb, 0x4b, 0x4f, 0x63, 0x04, 0x9a, 0xb4, 0xaa, 0x95,
+	0x10, 0x8d, 0x44, 0x13, 0x64, 0x52, 0x96, 0x6f, 0xf2, 0x7b, 0xd5, 0xbd, 0x08, 0x23, 0xcc, 0x79,
+	0x3a, 0xad, 0xe8, 0x2e, 0x48, 0xa1, 0x90, 0x65, 0x6b, 0x8e, 0x0e, 0xde, 0x88, 0xbb, 0x71, 0x51,
+	0x78, 0xd7, 0xdb, 0x72, 0x4b, 0xa2, 0x5f, 0x26, 0xfb, 0xe4, 0x68, 0xbd, 0x5b, 0x12, 0x7d, 0xcf,
+	0x73, 0xff, 0x2b, 0x90, 0xbc, 0x5c, 0xca, 0x48, 0x36, 0x7b, 0x37, 0xee, 0xb6, 0x84, 0x51, 0x10,
+	0xe3, 0x3d, 0xd7, 0x81, 0x19, 0x80, 0xe6, 0xe5, 0x7f, 0xe9, 0x71, 0xfb, 0x64, 0x32, 0xab, 0x3b,
+	0x5f, 0xb3, 0x7a, 0x2d, 0x0f, 0x63, 0xfa, 0xb7, 0x54, 0x20, 0x93, 0x60, 0x07, 0xf4, 0x9c, 0x47,
+	0x10, 0x3e, 0x74, 0x78, 0xf8, 0xf1, 0xde, 0x74, 0x57, 0x59, 0x3b, 0x3c, 0x7c, 0x5d, 0x8e, 0x1b,
+	0xa4, 0xbb, 0x29, 0x61, 0x74, 0x99, 0xba, 0x5d, 0xa5, 0x66, 0xa7, 0x95, 0x9f, 0x97, 0x3a, 0x79,
+	0x5a, 0x8e, 0x1b, 0x3b, 0x69, 0x33, 0xc5, 0x78, 0xed, 0xb3, 0xc9, 0xdc, 0x27, 0xd3, 0xb9, 0x4f,
+	0xbe, 0xe7, 0x3e, 0x79, 0x5e, 0xf8, 0xce, 0x74, 0xe1, 0x3b, 0x9f, 0x0b, 0xdf, 0xb9, 0x3e, 0x8c,
+	0x84, 0x1d, 0x0c, 0x7b, 0x34, 0x44, 0xc9, 0x0a, 0xad, 0x35, 0x8b, 0x2d, 0xf7, 0xd6, 0xb2, 0x5f,
+	0x1f, 0xff, 0x0e, 0x00, 0xba, 0xbb, 0x8e, 0x29, 0x88, 0x01, 0x00, 0x00,
+}
+
+func (this *Organization) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Organization)
+	if !ok {
+		that2, ok := that.(Organization)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if !this.MaxPowerShare.Equal(that1.MaxPowerShare) {
+		return false
+	}
+	return true
+}
+func (m *Organization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Organization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Organization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPowerShare.Size()
+		i -= size
+		if _, err := m.MaxPowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrganization(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOrganization(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintOrganization(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrganization(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrganization(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Organization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovOrganization(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOrganization(uint64(l))
+	}
+	l = m.MaxPowerShare.Size()
+	n += 1 + l + sovOrganization(uint64(l))
+	return n
+}
+
+func sovOrganization(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOrganization(x uint64) (n int) {
+	return sovOrganization(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Organization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrganization
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Organization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Organization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPowerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrganization(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOrganization(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOrganization
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOrganization
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOrganization
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOrganization
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOrganization        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOrganization          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOrganization = fmt.Errorf("proto: unexpected end of group")
+)
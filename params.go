@@ -1,10 +1,222 @@
 package poa
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"cosmossdk.io/math"
 )
 
-// DefaultParams returns the default x/staking parameters.
+// DefaultParams returns the default module parameters.
+func DefaultParams() Params {
+	return Params{
+		AllowValidatorSelfExit: true,
+		HybridMode:             false,
+		AdminPowerWeight:       math.LegacyNewDecWithPrec(5, 1),
+		MaxDelegatedPower:      0,
+		BlockedMsgTypeUrls:     DefaultBlockedMsgTypeURLs(),
+		CommissionLimits:       DefaultCommissionLimits(),
+		AccountAllowlist: AccountAllowlistParams{
+			Enabled:        false,
+			AllowOperators: true,
+		},
+		FeeDistribution: FeeDistributionParams{
+			Mode:          FeeDistributionModeUnspecified,
+			TreasuryShare: math.LegacyZeroDec(),
+		},
+		DowntimePolicy: DowntimePolicyParams{
+			Action:             DowntimeActionUnspecified,
+			MinSignedPerWindow: math.LegacyNewDecWithPrec(5, 1),
+			PowerReduction:     math.LegacyNewDecWithPrec(5, 1),
+		},
+	}
+}
+
+// DefaultBlockedMsgTypeURLs returns the messages blocked by default. The x/staking messages are wrapped by POA or
+// disabled since the admin controls the validator power, and withdrawing delegator rewards is disabled for
+// https://github.com/strangelove-ventures/poa/issues/170.
+func DefaultBlockedMsgTypeURLs() []string {
+	return []string{
+		sdk.MsgTypeURL(&stakingtypes.MsgCreateValidator{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgUpdateParams{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+		sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{}),
+	}
+}
+
+// HybridBlockedMsgTypeURLs returns the messages blocked by default in hybrid mode. Token holders delegating to the
+// validators withdraw their rewards, the x/staking delegation messages are validated by POA instead of blocked.
+func HybridBlockedMsgTypeURLs() []string {
+	withdrawReward := sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{})
+
+	return slices.DeleteFunc(DefaultBlockedMsgTypeURLs(), func(typeURL string) bool {
+		return typeURL == withdrawReward
+	})
+}
+
+// Validate performs basic validation of the module parameters.
+func (p Params) Validate() error {
+	if p.AdminPowerWeight.IsNil() {
+		return fmt.Errorf("admin power weight cannot be nil")
+	}
+
+	if !p.AdminPowerWeight.IsPositive() || p.AdminPowerWeight.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("admin power weight must be between 0 and 1 (exclusive), got %s", p.AdminPowerWeight)
+	}
+
+	if err := validateBlockedMsgTypeURLs(p.BlockedMsgTypeUrls); err != nil {
+		return err
+	}
+
+	if err := p.EqualPower.Validate(); err != nil {
+		return err
+	}
+
+	if p.HybridMode && p.EqualPower.Enabled {
+		return fmt.Errorf("hybrid mode and equal power mode can not be enabled together")
+	}
+
+	if err := p.FeeDistribution.Validate(); err != nil {
+		return err
+	}
+
+	if err := p.VirtualPower.Validate(); err != nil {
+		return err
+	}
+
+	if p.HybridMode && p.VirtualPower.Enabled {
+		return fmt.Errorf("hybrid mode and virtual power mode can not be enabled together")
+	}
+
+	if err := p.DowntimePolicy.Validate(); err != nil {
+		return err
+	}
+
+	// the power reduction would be undone by the rebalance, or blended with the delegated stake.
+	if p.DowntimePolicy.Action == DowntimeActionReducePower && (p.HybridMode || p.EqualPower.Enabled) {
+		return fmt.Errorf("downtime power reduction can not be used in hybrid mode or equal power mode")
+	}
+
+	if p.HybridMode && slices.Contains(p.BlockedMsgTypeUrls, sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{})) {
+		return fmt.Errorf("withdrawing delegator rewards can not be blocked in hybrid mode")
+	}
+
+	return p.CommissionLimits.Validate()
+}
+
+// Validate checks the power denom of an enabled virtual power mode.
+func (p VirtualPowerParams) Validate() error {
+	if !p.Enabled {
+		return nil
+	}
+
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return fmt.Errorf("invalid virtual power denom: %w", err)
+	}
+
+	return nil
+}
+
+// Validate checks the action of the downtime policy is known, and its thresholds when it is enabled.
+func (p DowntimePolicyParams) Validate() error {
+	if _, ok := DowntimeAction_name[int32(p.Action)]; !ok {
+		return fmt.Errorf("unknown downtime action %d", p.Action)
+	}
+
+	if p.Action == DowntimeActionUnspecified {
+		return nil
+	}
+
+	if p.MinSignedPerWindow.IsNil() || !p.MinSignedPerWindow.IsPositive() || p.MinSignedPerWindow.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("downtime min signed per window must be between 0 (exclusive) and 1 (inclusive), got %s", p.MinSignedPerWindow)
+	}
+
+	if p.Action != DowntimeActionReducePower {
+		return nil
+	}
+
+	if p.PowerReduction.IsNil() || !p.PowerReduction.IsPositive() || p.PowerReduction.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("downtime power reduction must be between 0 and 1 (exclusive), got %s", p.PowerReduction)
+	}
+
+	return nil
+}
+
+// Validate checks the equal power of the validators is set when the mode is enabled.
+func (p EqualPowerParams) Validate() error {
+	if p.Enabled && p.Power == 0 {
+		return fmt.Errorf("equal power must be positive when the equal power mode is enabled")
+	}
+
+	return nil
+}
+
+// Validate checks the fee distribution mode is known and the treasury receives a share [0, 1] of the fees.
+func (p FeeDistributionParams) Validate() error {
+	if _, ok := FeeDistributionMode_name[int32(p.Mode)]; !ok {
+		return fmt.Errorf("unknown fee distribution mode %d", p.Mode)
+	}
+
+	if p.TreasuryShare.IsNil() {
+		return fmt.Errorf("treasury share cannot be nil")
+	}
+
+	if p.TreasuryShare.IsNegative() || p.TreasuryShare.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("treasury share must be between 0 and 1 (inclusive), got %s", p.TreasuryShare)
+	}
+
+	if p.TreasuryAddress == "" {
+		if p.TreasuryShare.IsPositive() {
+			return fmt.Errorf("treasury share %s requires a treasury address", p.TreasuryShare)
+		}
+
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.TreasuryAddress); err != nil {
+		return fmt.Errorf("invalid treasury address %s: %w", p.TreasuryAddress, err)
+	}
+
+	return nil
+}
+
+// validateBlockedMsgTypeURLs checks the blocked type URLs are unique and well formed. POA messages can not be blocked,
+// the admin would not be able to update the params anymore.
+func validateBlockedMsgTypeURLs(typeURLs []string) error {
+	seen := make(map[string]bool, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		if !isValidMsgTypeURL(typeURL) {
+			return fmt.Errorf("invalid blocked message type url %q", typeURL)
+		}
+
+		if IsPOAMsgTypeURL(typeURL) {
+			return fmt.Errorf("poa message %s can not be blocked", typeURL)
+		}
+
+		if seen[typeURL] {
+			return fmt.Errorf("duplicate blocked message type url %s", typeURL)
+		}
+		seen[typeURL] = true
+	}
+
+	return nil
+}
+
+// isValidMsgTypeURL checks the type URL has the "/" prefix of the message type URLs, and no whitespace.
+func isValidMsgTypeURL(typeURL string) bool {
+	return strings.HasPrefix(typeURL, "/") && len(typeURL) > 1 && !strings.ContainsAny(typeURL, " \t\n")
+}
+
+// DefaultStakingParams returns the default x/staking parameters.
 func DefaultStakingParams() StakingParams {
 	sp := stakingtypes.DefaultParams()
 
@@ -17,3 +229,16 @@ func DefaultStakingParams() StakingParams {
 		MinCommissionRate: sp.MinCommissionRate,
 	}
 }
+
+// DefaultSlashingParams returns the default x/slashing parameters.
+func DefaultSlashingParams() SlashingParams {
+	sp := slashingtypes.DefaultParams()
+
+	return SlashingParams{
+		SignedBlocksWindow:      sp.SignedBlocksWindow,
+		MinSignedPerWindow:      sp.MinSignedPerWindow,
+		DowntimeJailDuration:    sp.DowntimeJailDuration,
+		SlashFractionDoubleSign: sp.SlashFractionDoubleSign,
+		SlashFractionDowntime:   sp.SlashFractionDowntime,
+	}
+}
@@ -30,36 +30,137 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// StakingParams defines the parameters for the x/staking module.
-type StakingParams struct {
-	// unbonding_time is the time duration of unbonding.
-	UnbondingTime time.Duration `protobuf:"bytes,1,opt,name=unbonding_time,json=unbondingTime,proto3,stdduration" json:"unbonding_time"`
-	// max_validators is the maximum number of validators.
-	MaxValidators uint32 `protobuf:"varint,2,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty"`
-	// max_entries is the max entries for either unbonding delegation or
-	// redelegation (per pair/trio).
-	MaxEntries uint32 `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
-	// historical_entries is the number of historical entries to persist.
-	HistoricalEntries uint32 `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty"`
-	// bond_denom defines the bondable coin denomination.
-	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
-	// min_commission_rate is the chain-wide minimum commission rate that a
-	// validator can charge their delegators
-	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate" yaml:"min_commission_rate"`
+// DowntimeAction defines the response of the POA downtime policy to a
+// validator missing too many blocks.
+type DowntimeAction int32
+
+const (
+	// DOWNTIME_ACTION_UNSPECIFIED disables the downtime policy, the downtime is
+	// left to x/slashing.
+	DowntimeActionUnspecified DowntimeAction = 0
+	// DOWNTIME_ACTION_NOTIFY only emits an event.
+	DowntimeActionNotify DowntimeAction = 1
+	// DOWNTIME_ACTION_REDUCE_POWER reduces the power of the validator by the
+	// power reduction of the policy.
+	DowntimeActionReducePower DowntimeAction = 2
+	// DOWNTIME_ACTION_STANDBY removes the validator from the active set and
+	// moves it back to the pending validators, for the admin to accept again.
+	DowntimeActionStandby DowntimeAction = 3
+	// DOWNTIME_ACTION_JAIL jails the validator without slashing it, until the
+	// x/slashing downtime jail duration has passed.
+	DowntimeActionJail DowntimeAction = 4
+)
+
+var DowntimeAction_name = map[int32]string{
+	0: "DOWNTIME_ACTION_UNSPECIFIED",
+	1: "DOWNTIME_ACTION_NOTIFY",
+	2: "DOWNTIME_ACTION_REDUCE_POWER",
+	3: "DOWNTIME_ACTION_STANDBY",
+	4: "DOWNTIME_ACTION_JAIL",
 }
 
-func (m *StakingParams) Reset()         { *m = StakingParams{} }
-func (m *StakingParams) String() string { return proto.CompactTextString(m) }
-func (*StakingParams) ProtoMessage()    {}
-func (*StakingParams) Descriptor() ([]byte, []int) {
+var DowntimeAction_value = map[string]int32{
+	"DOWNTIME_ACTION_UNSPECIFIED":  0,
+	"DOWNTIME_ACTION_NOTIFY":       1,
+	"DOWNTIME_ACTION_REDUCE_POWER": 2,
+	"DOWNTIME_ACTION_STANDBY":      3,
+	"DOWNTIME_ACTION_JAIL":         4,
+}
+
+func (x DowntimeAction) String() string {
+	return proto.EnumName(DowntimeAction_name, int32(x))
+}
+
+func (DowntimeAction) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_b1333a19bedb70c3, []int{0}
 }
-func (m *StakingParams) XXX_Unmarshal(b []byte) error {
+
+// FeeDistributionMode defines how the transaction fees are split between the
+// validators.
+type FeeDistributionMode int32
+
+const (
+	// FEE_DISTRIBUTION_MODE_UNSPECIFIED leaves the fees to x/distribution.
+	FeeDistributionModeUnspecified FeeDistributionMode = 0
+	// FEE_DISTRIBUTION_MODE_EQUAL splits the fees equally between the active
+	// validators.
+	FeeDistributionModeEqual FeeDistributionMode = 1
+	// FEE_DISTRIBUTION_MODE_POWER splits the fees proportionally to the
+	// consensus power of the active validators.
+	FeeDistributionModePower FeeDistributionMode = 2
+)
+
+var FeeDistributionMode_name = map[int32]string{
+	0: "FEE_DISTRIBUTION_MODE_UNSPECIFIED",
+	1: "FEE_DISTRIBUTION_MODE_EQUAL",
+	2: "FEE_DISTRIBUTION_MODE_POWER",
+}
+
+var FeeDistributionMode_value = map[string]int32{
+	"FEE_DISTRIBUTION_MODE_UNSPECIFIED": 0,
+	"FEE_DISTRIBUTION_MODE_EQUAL":       1,
+	"FEE_DISTRIBUTION_MODE_POWER":       2,
+}
+
+func (x FeeDistributionMode) String() string {
+	return proto.EnumName(FeeDistributionMode_name, int32(x))
+}
+
+func (FeeDistributionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b1333a19bedb70c3, []int{1}
+}
+
+// Params defines the parameters for the module.
+type Params struct {
+	// allow_validator_self_exit allows for a validator to remove themselves from
+	// the validator set.
+	AllowValidatorSelfExit bool `protobuf:"varint,2,opt,name=allow_validator_self_exit,json=allowValidatorSelfExit,proto3" json:"allow_validator_self_exit,omitempty"`
+	// hybrid_mode allows token holders to delegate to the validators the admin
+	// assigned power to. The consensus power of a validator is then a blend of
+	// its admin assigned power and its delegated stake.
+	HybridMode bool `protobuf:"varint,3,opt,name=hybrid_mode,json=hybridMode,proto3" json:"hybrid_mode,omitempty"`
+	// admin_power_weight is the share (0, 1) of the consensus power blend given
+	// to the admin assigned power in hybrid mode. The rest is given to the
+	// delegated stake.
+	AdminPowerWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=admin_power_weight,json=adminPowerWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"admin_power_weight"`
+	// max_delegated_power is the maximum power (10^6 precision, 1,000,000 = 1
+	// power) token holders may delegate to a single validator in hybrid mode,
+	// converted to tokens with the x/staking power reduction. 0 is no limit.
+	MaxDelegatedPower uint64 `protobuf:"varint,5,opt,name=max_delegated_power,json=maxDelegatedPower,proto3" json:"max_delegated_power,omitempty"`
+	// blocked_msg_type_urls are the message type URLs (e.g.
+	// /cosmos.staking.v1beta1.MsgDelegate) rejected by the POA message filter
+	// ante decorator, including messages nested in other messages.
+	BlockedMsgTypeUrls []string `protobuf:"bytes,6,rep,name=blocked_msg_type_urls,json=blockedMsgTypeUrls,proto3" json:"blocked_msg_type_urls,omitempty"`
+	// commission_limits are the bounds of the commission rates a validator may
+	// set when it is created or edited.
+	CommissionLimits CommissionLimits `protobuf:"bytes,7,opt,name=commission_limits,json=commissionLimits,proto3" json:"commission_limits"`
+	// account_allowlist restricts the accounts allowed to submit transactions.
+	AccountAllowlist AccountAllowlistParams `protobuf:"bytes,8,opt,name=account_allowlist,json=accountAllowlist,proto3" json:"account_allowlist"`
+	// equal_power keeps every active validator at the same consensus power.
+	EqualPower EqualPowerParams `protobuf:"bytes,9,opt,name=equal_power,json=equalPower,proto3" json:"equal_power"`
+	// fee_distribution pays the transaction fees to the validator operator
+	// accounts in place of the x/distribution delegator rewards.
+	FeeDistribution FeeDistributionParams `protobuf:"bytes,10,opt,name=fee_distribution,json=feeDistribution,proto3" json:"fee_distribution"`
+	// virtual_power backs the validator power with a dedicated non-transferable
+	// power denom instead of the supply of a fee or utility token.
+	VirtualPower VirtualPowerParams `protobuf:"bytes,11,opt,name=virtual_power,json=virtualPower,proto3" json:"virtual_power"`
+	// downtime_policy is the POA response to a validator missing too many
+	// blocks, in place of the x/slashing downtime jailing.
+	DowntimePolicy DowntimePolicyParams `protobuf:"bytes,12,opt,name=downtime_policy,json=downtimePolicy,proto3" json:"downtime_policy"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1333a19bedb70c3, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StakingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StakingParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -69,239 +170,3049 @@ func (m *StakingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *StakingParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StakingParams.Merge(m, src)
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
 }
-func (m *StakingParams) XXX_Size() int {
+func (m *Params) XXX_Size() int {
 	return m.Size()
 }
-func (m *StakingParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_StakingParams.DiscardUnknown(m)
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StakingParams proto.InternalMessageInfo
+var xxx_messageInfo_Params proto.InternalMessageInfo
 
-func (m *StakingParams) GetUnbondingTime() time.Duration {
+func (m *Params) GetAllowValidatorSelfExit() bool {
 	if m != nil {
-		return m.UnbondingTime
+		return m.AllowValidatorSelfExit
 	}
-	return 0
+	return false
 }
 
-func (m *StakingParams) GetMaxValidators() uint32 {
+func (m *Params) GetHybridMode() bool {
 	if m != nil {
-		return m.MaxValidators
+		return m.HybridMode
 	}
-	return 0
+	return false
 }
 
-func (m *StakingParams) GetMaxEntries() uint32 {
+func (m *Params) GetMaxDelegatedPower() uint64 {
 	if m != nil {
-		return m.MaxEntries
+		return m.MaxDelegatedPower
 	}
 	return 0
 }
 
-func (m *StakingParams) GetHistoricalEntries() uint32 {
+func (m *Params) GetBlockedMsgTypeUrls() []string {
 	if m != nil {
-		return m.HistoricalEntries
+		return m.BlockedMsgTypeUrls
 	}
-	return 0
+	return nil
 }
 
-func (m *StakingParams) GetBondDenom() string {
+func (m *Params) GetCommissionLimits() CommissionLimits {
 	if m != nil {
-		return m.BondDenom
+		return m.CommissionLimits
 	}
-	return ""
+	return CommissionLimits{}
 }
 
-func init() {
-	proto.RegisterType((*StakingParams)(nil), "strangelove_ventures.poa.v1.StakingParams")
+func (m *Params) GetAccountAllowlist() AccountAllowlistParams {
+	if m != nil {
+		return m.AccountAllowlist
+	}
+	return AccountAllowlistParams{}
 }
 
-func init() {
-	proto.RegisterFile("strangelove_ventures/poa/v1/params.proto", fileDescriptor_b1333a19bedb70c3)
+func (m *Params) GetEqualPower() EqualPowerParams {
+	if m != nil {
+		return m.EqualPower
+	}
+	return EqualPowerParams{}
 }
 
-var fileDescriptor_b1333a19bedb70c3 = []byte{
-	// 470 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0x14, 0x2a, 0xf5, 0x2a, 0x57, 0x8a, 0x61, 0x70, 0x53, 0x61, 0x47, 0x01, 0xa4,
-	0xa8, 0x52, 0x7c, 0x2a, 0x6c, 0x95, 0x58, 0x42, 0xd8, 0x90, 0x40, 0xa1, 0x62, 0x60, 0xb1, 0x5e,
-	0xec, 0xc3, 0x39, 0xc5, 0x77, 0x2f, 0xf2, 0x9d, 0xad, 0x74, 0x67, 0x62, 0x62, 0x64, 0x44, 0x62,
-	0x61, 0xec, 0xc0, 0x87, 0xe8, 0x58, 0x31, 0x21, 0x86, 0x80, 0x92, 0xa1, 0xcc, 0x7c, 0x02, 0x64,
-	0x9f, 0x1b, 0x3a, 0x74, 0xb1, 0x7c, 0xff, 0xf7, 0xbb, 0xf7, 0x7f, 0xf7, 0xde, 0xa3, 0x7d, 0x6d,
-	0x72, 0x50, 0x29, 0xcf, 0xb0, 0xe4, 0x51, 0xc9, 0x95, 0x29, 0x72, 0xae, 0xd9, 0x1c, 0x81, 0x95,
-	0x47, 0x6c, 0x0e, 0x39, 0x48, 0x1d, 0xce, 0x73, 0x34, 0xe8, 0x1e, 0xdc, 0x44, 0x86, 0x73, 0x84,
-	0xb0, 0x3c, 0xea, 0xdc, 0x4b, 0x31, 0xc5, 0x9a, 0x63, 0xd5, 0x9f, 0xbd, 0xd2, 0x69, 0x83, 0x14,
-	0x0a, 0x59, 0xfd, 0x6d, 0x24, 0x3f, 0x45, 0x4c, 0x33, 0xce, 0xea, 0xd3, 0xa4, 0x78, 0xc7, 0x92,
-	0x22, 0x07, 0x23, 0x50, 0x35, 0xf1, 0xfd, 0x18, 0xb5, 0x44, 0x1d, 0xd9, 0x5c, 0xf6, 0x60, 0x43,
-	0xbd, 0x2f, 0x5b, 0xd4, 0x79, 0x6d, 0x60, 0x26, 0x54, 0xfa, 0xaa, 0x2e, 0xcc, 0x7d, 0x49, 0xf7,
-	0x0a, 0x35, 0x41, 0x95, 0x08, 0x95, 0x46, 0x46, 0x48, 0xee, 0x91, 0x2e, 0xe9, 0xef, 0x3e, 0xde,
-	0x0f, 0xad, 0x4b, 0x78, 0xe5, 0x12, 0x8e, 0x1a, 0x97, 0xa1, 0x73, 0xbe, 0x0c, 0x5a, 0x9f, 0x7e,
-	0x05, 0xe4, 0xeb, 0xe5, 0xd9, 0x21, 0x19, 0x3b, 0x9b, 0xfb, 0x27, 0x42, 0x72, 0xf7, 0x11, 0xdd,
-	0x93, 0xb0, 0x88, 0x4a, 0xc8, 0x44, 0x02, 0x06, 0x73, 0xed, 0xdd, 0xea, 0x92, 0xbe, 0x33, 0x76,
-	0x24, 0x2c, 0xde, 0x6c, 0x44, 0x37, 0xa0, 0xbb, 0x15, 0xc6, 0x95, 0xc9, 0x05, 0xd7, 0xde, 0x56,
-	0xcd, 0x50, 0x09, 0x8b, 0xe7, 0x56, 0x71, 0x07, 0xd4, 0x9d, 0x0a, 0x6d, 0x30, 0x17, 0x31, 0x64,
-	0x1b, 0xee, 0x76, 0xcd, 0xb5, 0xff, 0x47, 0xae, 0xf0, 0xfb, 0x94, 0x56, 0x55, 0x44, 0x09, 0x57,
-	0x28, 0xbd, 0x3b, 0x5d, 0xd2, 0xdf, 0x19, 0xef, 0x54, 0xca, 0xa8, 0x12, 0xdc, 0xf7, 0x84, 0xde,
-	0x95, 0x42, 0x45, 0x31, 0x4a, 0x29, 0xb4, 0x16, 0xa8, 0xa2, 0x1c, 0x0c, 0xf7, 0xb6, 0x2b, 0x70,
-	0x78, 0x52, 0xbd, 0xe8, 0xe7, 0x32, 0x38, 0xb0, 0xcd, 0xd2, 0xc9, 0x2c, 0x14, 0xc8, 0x24, 0x98,
-	0x69, 0xf8, 0x82, 0xa7, 0x10, 0x9f, 0x8e, 0x78, 0xfc, 0x77, 0x19, 0x74, 0x4e, 0x41, 0x66, 0xc7,
-	0xbd, 0x1b, 0xf2, 0xf4, 0xbe, 0x7f, 0x1b, 0xd0, 0xa6, 0xd3, 0x23, 0x1e, 0xdb, 0xc6, 0xb4, 0xa5,
-	0x50, 0xcf, 0x36, 0xdc, 0x18, 0x0c, 0x3f, 0x7e, 0xf8, 0xe7, 0x73, 0x40, 0x3e, 0x5c, 0x9e, 0x1d,
-	0x36, 0x4e, 0x03, 0x9d, 0xcc, 0xd8, 0x82, 0x69, 0x3b, 0x12, 0x66, 0x67, 0x32, 0x7c, 0x7a, 0xbe,
-	0xf2, 0xc9, 0xc5, 0xca, 0x27, 0xbf, 0x57, 0x3e, 0xf9, 0xb8, 0xf6, 0x5b, 0x17, 0x6b, 0xbf, 0xf5,
-	0x63, 0xed, 0xb7, 0xde, 0x3e, 0x48, 0x85, 0x99, 0x16, 0x93, 0x30, 0x46, 0xc9, 0xae, 0xed, 0xd2,
-	0xe0, 0xfa, 0xd6, 0x4d, 0xb6, 0xeb, 0x91, 0x3d, 0xf9, 0x17, 0x00, 0x00, 0xff, 0xff, 0x13, 0x1a,
-	0xfc, 0xf3, 0x98, 0x02, 0x00, 0x00,
+func (m *Params) GetFeeDistribution() FeeDistributionParams {
+	if m != nil {
+		return m.FeeDistribution
+	}
+	return FeeDistributionParams{}
 }
 
-func (this *StakingParams) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *Params) GetVirtualPower() VirtualPowerParams {
+	if m != nil {
+		return m.VirtualPower
 	}
+	return VirtualPowerParams{}
+}
 
-	that1, ok := that.(*StakingParams)
-	if !ok {
-		that2, ok := that.(StakingParams)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Params) GetDowntimePolicy() DowntimePolicyParams {
+	if m != nil {
+		return m.DowntimePolicy
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return DowntimePolicyParams{}
+}
+
+// DowntimePolicyParams defines the POA downtime policy of the chain. The
+// missed blocks are read from the x/slashing signing info, over the x/slashing
+// signed blocks window.
+type DowntimePolicyParams struct {
+	// action is the response to a validator signing less than
+	// min_signed_per_window of the window.
+	Action DowntimeAction `protobuf:"varint,1,opt,name=action,proto3,enum=strangelove_ventures.poa.v1.DowntimeAction" json:"action,omitempty"`
+	// min_signed_per_window is the share (0, 1] of the signed blocks window a
+	// validator must sign.
+	MinSignedPerWindow cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_signed_per_window"`
+	// power_reduction is the share (0, 1) of the consensus power removed by
+	// DOWNTIME_ACTION_REDUCE_POWER. A validator keeps at least 1 consensus
+	// power.
+	PowerReduction cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=power_reduction,json=powerReduction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"power_reduction"`
+}
+
+func (m *DowntimePolicyParams) Reset()         { *m = DowntimePolicyParams{} }
+func (m *DowntimePolicyParams) String() string { return proto.CompactTextString(m) }
+func (*DowntimePolicyParams) ProtoMessage()    {}
+func (*DowntimePolicyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1333a19bedb70c3, []int{1}
+}
+func (m *DowntimePolicyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimePolicyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimePolicyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.UnbondingTime != that1.UnbondingTime {
-		return false
+}
+func (m *DowntimePolicyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimePolicyParams.Merge(m, src)
+}
+func (m *DowntimePolicyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimePolicyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimePolicyParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimePolicyParams proto.InternalMessageInfo
+
+func (m *DowntimePolicyParams) GetAction() DowntimeAction {
+	if m != nil {
+		return m.Action
 	}
-	if this.MaxValidators != that1.MaxValidators {
-		return false
+	return DowntimeActionUnspecified
+}
+
+// VirtualPowerParams defines the virtual power mode of the chain.
+type VirtualPowerParams struct {
+	// enabled makes denom the x/staking bond denom and blocks its transfers. The
+	// bonded pool is converted to denom when the mode is enabled.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// denom is the power denom the bonded pool is minted in. It must not have a
+	// supply outside of the x/staking pools.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *VirtualPowerParams) Reset()         { *m = VirtualPowerParams{} }
+func (m *VirtualPowerParams) String() string { return proto.CompactTextString(m) }
+func (*VirtualPowerParams) ProtoMessage()    {}
+func (*VirtualPowerParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1333a19bedb70c3, []int{2}
+}
+func (m *VirtualPowerParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VirtualPowerParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VirtualPowerParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.MaxEntries != that1.MaxEntries {
-		return false
+}
+func (m *VirtualPowerParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VirtualPowerParams.Merge(m, src)
+}
+func (m *VirtualPowerParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *VirtualPowerParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_VirtualPowerParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VirtualPowerParams proto.InternalMessageInfo
+
+func (m *VirtualPowerParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	if this.HistoricalEntries != that1.HistoricalEntries {
-		return false
+	return false
+}
+
+func (m *VirtualPowerParams) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if this.BondDenom != that1.BondDenom {
-		return false
+	return ""
+}
+
+// FeeDistributionParams defines the POA fee distribution of the chain.
+type FeeDistributionParams struct {
+	// mode is how the fees are split between the active validators.
+	Mode FeeDistributionMode `protobuf:"varint,1,opt,name=mode,proto3,enum=strangelove_ventures.poa.v1.FeeDistributionMode" json:"mode,omitempty"`
+	// treasury_address is the account receiving the treasury share of the fees.
+	TreasuryAddress string `protobuf:"bytes,2,opt,name=treasury_address,json=treasuryAddress,proto3" json:"treasury_address,omitempty"`
+	// treasury_share is the share [0, 1] of the fees paid to the treasury
+	// before the validators are paid.
+	TreasuryShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=treasury_share,json=treasuryShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"treasury_share"`
+}
+
+func (m *FeeDistributionParams) Reset()         { *m = FeeDistributionParams{} }
+func (m *FeeDistributionParams) String() string { return proto.CompactTextString(m) }
+func (*FeeDistributionParams) ProtoMessage()    {}
+func (*FeeDistributionParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1333a19bedb70c3, []int{3}
+}
+func (m *FeeDistributionParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDistributionParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDistributionParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
-		return false
+}
+func (m *FeeDistributionParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDistributionParams.Merge(m, src)
+}
+func (m *FeeDistributionParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDistributionParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDistributionParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDistributionParams proto.InternalMessageInfo
+
+func (m *FeeDistributionParams) GetMode() FeeDistributionMode {
+	if m != nil {
+		return m.Mode
 	}
-	return true
+	return FeeDistributionModeUnspecified
 }
-func (m *StakingParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *FeeDistributionParams) GetTreasuryAddress() string {
+	if m != nil {
+		return m.TreasuryAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *StakingParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EqualPowerParams defines the equal power mode of the chain.
+type EqualPowerParams struct {
+	// enabled rebalances the active validators to the same power every block.
+	// The admin may then only accept or remove validators.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// power is the shares (10^6 precision, 1,000,000 = 1 power) every active
+	// validator is rebalanced to.
+	Power uint64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
 }
 
-func (m *StakingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MinCommissionRate.Size()
-		i -= size
-		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EqualPowerParams) Reset()         { *m = EqualPowerParams{} }
+func (m *EqualPowerParams) String() string { return proto.CompactTextString(m) }
+func (*EqualPowerParams) ProtoMessage()    {}
+func (*EqualPowerParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1333a19bedb70c3, []int{4}
+}
+func (m *EqualPowerParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EqualPowerParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EqualPowerParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.BondDenom) > 0 {
-		i -= len(m.BondDenom)
-		copy(dAtA[i:], m.BondDenom)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.BondDenom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.HistoricalEntries != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.HistoricalEntries))
-		i--
-		dAtA[i] = 0x20
+		return b[:n], nil
 	}
-	if m.MaxEntries != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.MaxEntries))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *EqualPowerParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EqualPowerParams.Merge(m, src)
+}
+func (m *EqualPowerParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *EqualPowerParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_EqualPowerParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EqualPowerParams proto.InternalMessageInfo
+
+func (m *EqualPowerParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	if m.MaxValidators != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.MaxValidators))
-		i--
-		dAtA[i] = 0x10
+	return false
+}
+
+func (m *EqualPowerParams) GetPower() uint64 {
+	if m != nil {
+		return m.Power
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err1 != nil {
-		return 0, err1
+	return 0
+}
+
+// AccountAllowlistParams defines the account allowlist of the chain.
+type AccountAllowlistParams struct {
+	// enabled rejects the transactions signed by an account which is not
+	// allowed by the account allowlist ante decorator.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// allow_operators always allows the authority and the validator operators
+	// to submit transactions.
+	AllowOperators bool `protobuf:"varint,2,opt,name=allow_operators,json=allowOperators,proto3" json:"allow_operators,omitempty"`
+}
+
+func (m *AccountAllowlistParams) Reset()         { *m = AccountAllowlistParams{} }
+func (m *AccountAllowlistParams) String() string { return proto.CompactTextString(m) }
+func (*AccountAllowlistParams) ProtoMessage()    {}
+func (*AccountAllowlistParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1333a19bedb70c3, []int{5}
+}
+func (m *AccountAllowlistParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountAllowlistParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountAllowlistParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountAllowlistParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAllowlistParams.Merge(m, src)
+}
+func (m *AccountAllowlistParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountAllowlistParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAllowlistParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAllowlistParams proto.InternalMessageInfo
+
+func (m *AccountAllowlistParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *AccountAllowlistParams) GetAllowOperators() bool {
+	if m != nil {
+		return m.AllowOperators
+	}
+	return false
+}
+
+// CommissionLimits defines the bounds of the validator commission rates.
+type CommissionLimits struct {
+	// rate bounds the commission rate.
+	Rate DecRange `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate"`
+	// max_rate bounds the maximum commission rate.
+	MaxRate DecRange `protobuf:"bytes,2,opt,name=max_rate,json=maxRate,proto3" json:"max_rate"`
+	// max_change_rate bounds the maximum daily commission rate change.
+	MaxChangeRate DecRange `protobuf:"bytes,3,opt,name=max_change_rate,json=maxChangeRate,proto3" json:"max_change_rate"`
+}
+
+func (m *CommissionLimits) Reset()         { *m = CommissionLimits{} }
+func (m *CommissionLimits) String() string { return proto.CompactTextString(m) }
+func (*CommissionLimits) ProtoMessage()    {}
+func (*CommissionLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1333a19bedb70c3, []int{6}
+}
+func (m *CommissionLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionLimits.Merge(m, src)
+}
+func (m *CommissionLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionLimits proto.InternalMessageInfo
+
+func (m *CommissionLimits) GetRate() DecRange {
+	if m != nil {
+		return m.Rate
+	}
+	return DecRange{}
+}
+
+func (m *CommissionLimits) GetMaxRate() DecRange {
+	if m != nil {
+		return m.MaxRate
+	}
+	return DecRange{}
+}
+
+func (m *CommissionLimits) GetMaxChangeRate() DecRange {
+	if m != nil {
+		return m.MaxChangeRate
+	}
+	return DecRange{}
+}
+
+// DecRange defines an inclusive range of decimals.
+type DecRange struct {
+	// floor is the lowest value of the range.
+	Floor cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=floor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"floor"`
+	// ceil is the highest value of the range.
+	Ceil cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=ceil,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ceil"`
+}
+
+func (m *DecRange) Reset()         { *m = DecRange{} }
+func (m *DecRange) String() string { return proto.CompactTextString(m) }
+func (*DecRange) ProtoMessage()    {}
+func (*DecRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1333a19bedb70c3, []int{7}
+}
+func (m *DecRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecRange.Merge(m, src)
+}
+func (m *DecRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecRange proto.InternalMessageInfo
+
+// StakingParams defines the parameters for the x/staking module.
+type StakingParams struct {
+	// unbonding_time is the time duration of unbonding.
+	UnbondingTime time.Duration `protobuf:"bytes,1,opt,name=unbonding_time,json=unbondingTime,proto3,stdduration" json:"unbonding_time"`
+	// max_validators is the maximum number of validators.
+	MaxValidators uint32 `protobuf:"varint,2,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty"`
+	// max_entries is the max entries for either unbonding delegation or
+	// redelegation (per pair/trio).
+	MaxEntries uint32 `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
+	// historical_entries is the number of historical entries to persist.
+	HistoricalEntries uint32 `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty"`
+	// bond_denom defines the bondable coin denomination.
+	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	// min_commission_rate is the chain-wide minimum commission rate that a
+	// validator can charge their delegators
+	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate" yaml:"min_commission_rate"`
+}
+
+func (m *StakingParams) Reset()         { *m = StakingParams{} }
+func (m *StakingParams) String() string { return proto.CompactTextString(m) }
+func (*StakingParams) ProtoMessage()    {}
+func (*StakingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1333a19bedb70c3, []int{8}
+}
+func (m *StakingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingParams.Merge(m, src)
+}
+func (m *StakingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingParams proto.InternalMessageInfo
+
+func (m *StakingParams) GetUnbondingTime() time.Duration {
+	if m != nil {
+		return m.UnbondingTime
+	}
+	return 0
+}
+
+func (m *StakingParams) GetMaxValidators() uint32 {
+	if m != nil {
+		return m.MaxValidators
+	}
+	return 0
+}
+
+func (m *StakingParams) GetMaxEntries() uint32 {
+	if m != nil {
+		return m.MaxEntries
+	}
+	return 0
+}
+
+func (m *StakingParams) GetHistoricalEntries() uint32 {
+	if m != nil {
+		return m.HistoricalEntries
+	}
+	return 0
+}
+
+func (m *StakingParams) GetBondDenom() string {
+	if m != nil {
+		return m.BondDenom
+	}
+	return ""
+}
+
+// SlashingParams defines the parameters for the x/slashing module.
+type SlashingParams struct {
+	// signed_blocks_window is the number of blocks the liveness of a validator
+	// is tracked over.
+	SignedBlocksWindow int64 `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	// min_signed_per_window is the share of the signed blocks window a validator
+	// must sign to not be jailed for downtime.
+	MinSignedPerWindow cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_signed_per_window" yaml:"min_signed_per_window"`
+	// downtime_jail_duration is the time a validator jailed for downtime must
+	// wait before it unjails.
+	DowntimeJailDuration time.Duration `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
+	// slash_fraction_double_sign is the share of the stake slashed for a double
+	// sign.
+	SlashFractionDoubleSign cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
+	// slash_fraction_downtime is the share of the stake slashed for downtime.
+	SlashFractionDowntime cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+}
+
+func (m *SlashingParams) Reset()         { *m = SlashingParams{} }
+func (m *SlashingParams) String() string { return proto.CompactTextString(m) }
+func (*SlashingParams) ProtoMessage()    {}
+func (*SlashingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1333a19bedb70c3, []int{9}
+}
+func (m *SlashingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingParams.Merge(m, src)
+}
+func (m *SlashingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingParams proto.InternalMessageInfo
+
+func (m *SlashingParams) GetSignedBlocksWindow() int64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (m *SlashingParams) GetDowntimeJailDuration() time.Duration {
+	if m != nil {
+		return m.DowntimeJailDuration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("strangelove_ventures.poa.v1.DowntimeAction", DowntimeAction_name, DowntimeAction_value)
+	proto.RegisterEnum("strangelove_ventures.poa.v1.FeeDistributionMode", FeeDistributionMode_name, FeeDistributionMode_value)
+	proto.RegisterType((*Params)(nil), "strangelove_ventures.poa.v1.Params")
+	proto.RegisterType((*DowntimePolicyParams)(nil), "strangelove_ventures.poa.v1.DowntimePolicyParams")
+	proto.RegisterType((*VirtualPowerParams)(nil), "strangelove_ventures.poa.v1.VirtualPowerParams")
+	proto.RegisterType((*FeeDistributionParams)(nil), "strangelove_ventures.poa.v1.FeeDistributionParams")
+	proto.RegisterType((*EqualPowerParams)(nil), "strangelove_ventures.poa.v1.EqualPowerParams")
+	proto.RegisterType((*AccountAllowlistParams)(nil), "strangelove_ventures.poa.v1.AccountAllowlistParams")
+	proto.RegisterType((*CommissionLimits)(nil), "strangelove_ventures.poa.v1.CommissionLimits")
+	proto.RegisterType((*DecRange)(nil), "strangelove_ventures.poa.v1.DecRange")
+	proto.RegisterType((*StakingParams)(nil), "strangelove_ventures.poa.v1.StakingParams")
+	proto.RegisterType((*SlashingParams)(nil), "strangelove_ventures.poa.v1.SlashingParams")
+}
+
+func init() {
+	proto.RegisterFile("strangelove_ventures/poa/v1/params.proto", fileDescriptor_b1333a19bedb70c3)
+}
+
+var fileDescriptor_b1333a19bedb70c3 = []byte{
+	// 1565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xdd, 0x4f, 0x1b, 0xcb,
+	0x15, 0x67, 0x6d, 0xf3, 0x35, 0x04, 0x63, 0x86, 0x8f, 0x18, 0x43, 0x8c, 0xe3, 0x36, 0x2a, 0xa2,
+	0xc2, 0x0e, 0xa4, 0x8a, 0x54, 0xa4, 0xb4, 0xb2, 0xb1, 0x51, 0x4d, 0xf9, 0xea, 0xda, 0x84, 0xa4,
+	0x55, 0xbb, 0x1a, 0xef, 0x8e, 0xed, 0x29, 0xbb, 0x3b, 0xce, 0xce, 0x1a, 0xec, 0xe7, 0xb6, 0x6a,
+	0xcb, 0x53, 0x1e, 0xf3, 0x82, 0x54, 0x29, 0x2f, 0x6d, 0xa5, 0x4a, 0x79, 0x88, 0xd4, 0x7f, 0x21,
+	0x8f, 0x51, 0x9e, 0xae, 0xee, 0x43, 0xee, 0x55, 0x72, 0xa5, 0xdc, 0xe7, 0xfb, 0x17, 0x5c, 0xcd,
+	0xcc, 0xae, 0xc1, 0xc6, 0x58, 0x24, 0xbc, 0x20, 0x76, 0xce, 0xf9, 0xfd, 0xce, 0xc7, 0x9c, 0x39,
+	0xe7, 0x18, 0x2c, 0x31, 0xd7, 0x41, 0x76, 0x15, 0x9b, 0xf4, 0x18, 0x6b, 0xc7, 0xd8, 0x76, 0x1b,
+	0x0e, 0x66, 0xe9, 0x3a, 0x45, 0xe9, 0xe3, 0xd5, 0x74, 0x1d, 0x39, 0xc8, 0x62, 0xa9, 0xba, 0x43,
+	0x5d, 0x0a, 0xe7, 0x7b, 0x69, 0xa6, 0xea, 0x14, 0xa5, 0x8e, 0x57, 0x63, 0xd3, 0x55, 0x5a, 0xa5,
+	0x42, 0x2f, 0xcd, 0xff, 0x93, 0x90, 0xd8, 0x24, 0xb2, 0x88, 0x4d, 0xd3, 0xe2, 0xaf, 0x77, 0x14,
+	0xaf, 0x52, 0x5a, 0x35, 0x71, 0x5a, 0x7c, 0x95, 0x1b, 0x95, 0xb4, 0xd1, 0x70, 0x90, 0x4b, 0xa8,
+	0xed, 0xc9, 0xe7, 0x74, 0xca, 0x2c, 0xca, 0x34, 0xc9, 0x25, 0x3f, 0xa4, 0x28, 0xf9, 0x9f, 0x61,
+	0x30, 0xb4, 0x2f, 0x3c, 0x82, 0xbf, 0x04, 0x73, 0xc8, 0x34, 0xe9, 0x89, 0x76, 0x8c, 0x4c, 0x62,
+	0x20, 0x97, 0x3a, 0x1a, 0xc3, 0x66, 0x45, 0xc3, 0x4d, 0xe2, 0x46, 0x03, 0x09, 0x65, 0x69, 0x44,
+	0x9d, 0x15, 0x0a, 0x8f, 0x7d, 0x79, 0x11, 0x9b, 0x95, 0x7c, 0x93, 0xb8, 0x70, 0x11, 0x8c, 0xd5,
+	0x5a, 0x65, 0x87, 0x18, 0x9a, 0x45, 0x0d, 0x1c, 0x0d, 0x0a, 0x65, 0x20, 0x8f, 0x76, 0xa8, 0x81,
+	0xa1, 0x01, 0x20, 0x32, 0x2c, 0x62, 0x6b, 0x75, 0x7a, 0x82, 0x1d, 0xed, 0x04, 0x93, 0x6a, 0xcd,
+	0x8d, 0x86, 0x12, 0xca, 0xd2, 0x68, 0xf6, 0xe1, 0x9b, 0xf7, 0x8b, 0x03, 0x5f, 0xbf, 0x5f, 0x9c,
+	0x97, 0x8e, 0x31, 0xe3, 0x28, 0x45, 0x68, 0xda, 0x42, 0x6e, 0x2d, 0xb5, 0x8d, 0xab, 0x48, 0x6f,
+	0xe5, 0xb0, 0xfe, 0xee, 0xf5, 0x0a, 0xf0, 0xfc, 0xce, 0x61, 0xfd, 0xdf, 0x9f, 0x5e, 0x2d, 0x2b,
+	0x6a, 0x44, 0x30, 0xee, 0x73, 0xc2, 0x43, 0xc1, 0x07, 0x53, 0x60, 0xca, 0x42, 0x4d, 0xcd, 0xc0,
+	0x26, 0xae, 0x22, 0x17, 0x1b, 0xd2, 0x5a, 0x74, 0x30, 0xa1, 0x2c, 0x85, 0xd4, 0x49, 0x0b, 0x35,
+	0x73, 0xbe, 0x44, 0xa0, 0xe0, 0x2a, 0x98, 0x29, 0x9b, 0x54, 0x3f, 0xc2, 0x86, 0x66, 0xb1, 0xaa,
+	0xe6, 0xb6, 0xea, 0x58, 0x6b, 0x38, 0x26, 0x8b, 0x0e, 0x25, 0x82, 0x4b, 0xa3, 0x2a, 0xf4, 0x84,
+	0x3b, 0xac, 0x5a, 0x6a, 0xd5, 0xf1, 0x81, 0x63, 0x32, 0x88, 0xc1, 0xa4, 0x4e, 0x2d, 0x8b, 0x30,
+	0x46, 0xa8, 0xad, 0x99, 0xc4, 0x22, 0x2e, 0x8b, 0x0e, 0x27, 0x94, 0xa5, 0xb1, 0xb5, 0x95, 0x54,
+	0x9f, 0xcb, 0x4c, 0x6d, 0xb4, 0x51, 0xdb, 0x02, 0x94, 0x1d, 0xe5, 0x61, 0x7b, 0x91, 0xe8, 0x5d,
+	0x42, 0x78, 0x04, 0x26, 0x91, 0xae, 0xd3, 0x86, 0xed, 0x6a, 0x22, 0xe5, 0x26, 0x61, 0x6e, 0x74,
+	0x44, 0x98, 0x79, 0xd0, 0xd7, 0x4c, 0x46, 0xa2, 0x32, 0x3e, 0x48, 0xde, 0x6d, 0x87, 0x31, 0xd4,
+	0xa5, 0x02, 0x9f, 0x82, 0x31, 0xfc, 0xac, 0x81, 0x4c, 0x2f, 0x5d, 0xa3, 0xd7, 0x88, 0x26, 0xcf,
+	0xf5, 0x45, 0x12, 0x2f, 0x1b, 0x00, 0xb8, 0x2d, 0x84, 0x35, 0x10, 0xa9, 0x60, 0xac, 0x19, 0x84,
+	0xb9, 0x0e, 0x29, 0x37, 0x78, 0x4d, 0x46, 0x81, 0xe0, 0x5f, 0xeb, 0xcb, 0xbf, 0x89, 0x71, 0xee,
+	0x02, 0xe6, 0xb2, 0x91, 0x89, 0x4a, 0xa7, 0x06, 0xd4, 0xc0, 0xf8, 0x31, 0x71, 0xdc, 0xf3, 0x30,
+	0xc6, 0x84, 0x99, 0x74, 0x5f, 0x33, 0x8f, 0x25, 0xe2, 0x8a, 0x40, 0x6e, 0x1d, 0x5f, 0x10, 0x43,
+	0x0c, 0x26, 0x0c, 0x7a, 0x62, 0xbb, 0xc4, 0xc2, 0x5a, 0x9d, 0x9a, 0x44, 0x6f, 0x45, 0x6f, 0x09,
+	0x13, 0xab, 0x7d, 0x4d, 0xe4, 0x3c, 0xcc, 0xbe, 0x80, 0x5c, 0x36, 0x12, 0x36, 0x3a, 0x14, 0xd6,
+	0xa7, 0xbe, 0xff, 0xd7, 0xa2, 0x72, 0xfa, 0xe9, 0xd5, 0x32, 0xe0, 0xfd, 0x42, 0xea, 0x6f, 0x85,
+	0x46, 0x94, 0x48, 0x20, 0xf9, 0xbf, 0x00, 0x98, 0xee, 0x45, 0x07, 0x37, 0xc0, 0x10, 0xd2, 0x45,
+	0x6e, 0x95, 0x84, 0xb2, 0x14, 0x5e, 0xfb, 0xf9, 0xb5, 0x3c, 0xca, 0x08, 0x88, 0xea, 0x41, 0x21,
+	0x01, 0x33, 0xfc, 0x81, 0x32, 0x52, 0xb5, 0xf9, 0xcb, 0xe1, 0xaf, 0x94, 0xd8, 0x06, 0x3d, 0x11,
+	0x4f, 0xff, 0xcb, 0x5f, 0x29, 0xb4, 0x88, 0x5d, 0x14, 0x9c, 0xfb, 0xd8, 0x39, 0x14, 0x8c, 0x50,
+	0x03, 0x13, 0xb2, 0x0f, 0x38, 0xd8, 0x68, 0x48, 0xc7, 0x83, 0x37, 0x32, 0x12, 0x16, 0x74, 0xaa,
+	0xcf, 0xb6, 0x1e, 0xe2, 0x49, 0x4c, 0x6e, 0x01, 0x78, 0xf9, 0x82, 0x61, 0x14, 0x0c, 0x63, 0x1b,
+	0x95, 0x4d, 0x6c, 0x88, 0x6c, 0x8d, 0xa8, 0xfe, 0x27, 0x9c, 0x06, 0x83, 0x06, 0xb6, 0xa9, 0x25,
+	0x23, 0x56, 0xe5, 0x87, 0xc7, 0xf5, 0xf7, 0x00, 0x98, 0xe9, 0x59, 0x94, 0x30, 0x07, 0x42, 0xa2,
+	0xe9, 0xc9, 0xd4, 0xdf, 0xff, 0x9c, 0xb2, 0xe6, 0xad, 0x51, 0x15, 0x68, 0xb8, 0x01, 0x22, 0xae,
+	0x83, 0x11, 0x6b, 0x38, 0x2d, 0x0d, 0x19, 0x86, 0x83, 0x19, 0xf3, 0x12, 0x1f, 0x7d, 0xf7, 0x7a,
+	0x65, 0xda, 0x0b, 0x38, 0x23, 0x25, 0x45, 0xd7, 0x21, 0x76, 0x55, 0x9d, 0xf0, 0x11, 0xde, 0x31,
+	0xfc, 0x23, 0x08, 0xb7, 0x49, 0x58, 0x0d, 0x39, 0xf8, 0x86, 0x69, 0x1d, 0xf7, 0xd9, 0x8a, 0x9c,
+	0xcc, 0xcb, 0xc4, 0x6f, 0x40, 0x24, 0xff, 0xec, 0x73, 0x72, 0x2a, 0x9f, 0x63, 0x40, 0x34, 0x61,
+	0xf9, 0xe1, 0x31, 0x21, 0x30, 0xdb, 0xbb, 0x5d, 0xf5, 0xe1, 0xfb, 0x19, 0x98, 0x90, 0x43, 0x8a,
+	0xd6, 0xb1, 0xc3, 0x67, 0x10, 0xf3, 0x46, 0x53, 0x58, 0x1c, 0xef, 0xf9, 0xa7, 0x9e, 0x89, 0xbf,
+	0x05, 0x40, 0xa4, 0xbb, 0xf3, 0xf2, 0x1b, 0x73, 0x90, 0x2b, 0x6f, 0x6c, 0x6c, 0xed, 0x5e, 0xff,
+	0xc7, 0x82, 0x75, 0x95, 0x0b, 0x2f, 0x3e, 0x59, 0x81, 0x86, 0xbf, 0x05, 0x23, 0x7c, 0xd8, 0x08,
+	0xa6, 0xc0, 0x17, 0x32, 0x0d, 0x5b, 0xa8, 0xa9, 0x72, 0xb2, 0x27, 0x60, 0x82, 0x93, 0xe9, 0x35,
+	0xae, 0x21, 0x39, 0x83, 0x5f, 0xc8, 0x39, 0x6e, 0xa1, 0xe6, 0x86, 0xe0, 0xe1, 0xcc, 0x5e, 0x1e,
+	0xfe, 0xab, 0x80, 0x11, 0x5f, 0x19, 0x6e, 0x83, 0xc1, 0x8a, 0x49, 0xa9, 0x13, 0x55, 0x6e, 0x54,
+	0x1d, 0x92, 0x04, 0x6e, 0x81, 0x90, 0x8e, 0x89, 0x79, 0xc3, 0x36, 0x21, 0x38, 0x3c, 0x67, 0x5f,
+	0x06, 0xc1, 0x78, 0xd1, 0x45, 0x47, 0xc4, 0xae, 0x7a, 0xf5, 0xb0, 0x07, 0xc2, 0x0d, 0xbb, 0x4c,
+	0x6d, 0x83, 0xd8, 0x55, 0x8d, 0xf7, 0x2e, 0xef, 0xee, 0xe6, 0x52, 0x72, 0xf3, 0x49, 0xf9, 0x9b,
+	0x4f, 0x2a, 0xe7, 0x6d, 0x3e, 0xd9, 0x71, 0xee, 0xc8, 0x8b, 0x6f, 0x16, 0x15, 0x2f, 0x2b, 0x6d,
+	0x7c, 0x89, 0x58, 0x18, 0xde, 0x03, 0x61, 0x9e, 0xef, 0xf6, 0xa6, 0x23, 0xab, 0x68, 0x5c, 0x24,
+	0xaf, 0xbd, 0xde, 0x30, 0xbe, 0xd7, 0x70, 0x35, 0x6c, 0xbb, 0x0e, 0xc1, 0x4c, 0x5c, 0xc9, 0xb8,
+	0x0a, 0x2c, 0xd4, 0xcc, 0xcb, 0x13, 0xb8, 0x02, 0x60, 0x8d, 0x30, 0x97, 0x3a, 0x44, 0x47, 0x66,
+	0x5b, 0x2f, 0x24, 0xf4, 0x26, 0xcf, 0x25, 0xbe, 0xfa, 0x1d, 0x00, 0xb8, 0x17, 0x9a, 0x6c, 0x33,
+	0x83, 0xa2, 0xcd, 0x8c, 0xf2, 0x93, 0x1c, 0x3f, 0x80, 0x7f, 0x55, 0xc0, 0x14, 0xef, 0xc1, 0x17,
+	0x36, 0x0c, 0x51, 0x0a, 0x43, 0x22, 0xb5, 0xa5, 0x6b, 0xa4, 0xf6, 0x87, 0xf7, 0x8b, 0xb1, 0x16,
+	0xb2, 0xcc, 0xf5, 0x64, 0x0f, 0x9e, 0x64, 0xaf, 0xc4, 0x4f, 0x5a, 0xc4, 0x3e, 0x7f, 0x21, 0xa2,
+	0x64, 0x7e, 0xea, 0x8f, 0x20, 0xcf, 0xd2, 0x0a, 0x33, 0x8e, 0xd2, 0xcd, 0x34, 0x93, 0x57, 0xe2,
+	0xcd, 0xa4, 0xe4, 0x5f, 0x06, 0x41, 0xb8, 0x68, 0x22, 0x56, 0x3b, 0xbf, 0xa6, 0xfb, 0x60, 0xda,
+	0x1b, 0x1f, 0x62, 0x73, 0x62, 0xfe, 0x04, 0xe1, 0x97, 0x15, 0x54, 0xa1, 0x94, 0x65, 0x85, 0xc8,
+	0x9b, 0x04, 0xff, 0x54, 0xfa, 0x4f, 0x9d, 0xc7, 0xd7, 0x8b, 0x79, 0xe1, 0x3c, 0xe6, 0x4b, 0x4c,
+	0xc9, 0xeb, 0x4e, 0xa5, 0x3f, 0x81, 0xd9, 0xf6, 0x80, 0xff, 0x33, 0x22, 0xa6, 0xe6, 0x6f, 0xd1,
+	0xd1, 0xe0, 0x67, 0x16, 0xdb, 0xb4, 0xcf, 0xb3, 0x85, 0x88, 0xe9, 0x2b, 0xc1, 0x17, 0x0a, 0x88,
+	0x31, 0x9e, 0x30, 0xad, 0xe2, 0xc8, 0x99, 0xab, 0x19, 0xb4, 0x51, 0x36, 0xb1, 0xf0, 0xd9, 0x5b,
+	0x86, 0xff, 0x70, 0xbd, 0x80, 0xef, 0xca, 0x80, 0xaf, 0xa6, 0xeb, 0x19, 0xf5, 0x6d, 0xa1, 0xbf,
+	0xe9, 0xa9, 0xe7, 0x84, 0x36, 0xcf, 0x02, 0x7c, 0xae, 0x80, 0xdb, 0x97, 0xb8, 0x64, 0x08, 0xb2,
+	0x4a, 0xb3, 0x4f, 0xae, 0xe7, 0x57, 0xfc, 0x0a, 0xbf, 0x24, 0x57, 0x4f, 0xa7, 0x66, 0xba, 0x9c,
+	0x92, 0xaa, 0xeb, 0xf7, 0xfc, 0x22, 0x5c, 0xe8, 0x2c, 0x42, 0xaf, 0xe2, 0xbc, 0x2a, 0x5c, 0xfe,
+	0x7f, 0x00, 0x84, 0x3b, 0x17, 0x1a, 0xf8, 0x2b, 0x30, 0x9f, 0xdb, 0x3b, 0xdc, 0x2d, 0x15, 0x76,
+	0xf2, 0x5a, 0x66, 0xa3, 0x54, 0xd8, 0xdb, 0xd5, 0x0e, 0x76, 0x8b, 0xfb, 0xf9, 0x8d, 0xc2, 0x66,
+	0x21, 0x9f, 0x8b, 0x0c, 0xc4, 0xee, 0x9c, 0x9e, 0x25, 0xe6, 0x3a, 0x41, 0x07, 0x36, 0xab, 0x63,
+	0x9d, 0x54, 0x08, 0x36, 0xe0, 0x2f, 0xc0, 0x6c, 0x37, 0x7e, 0x77, 0xaf, 0x54, 0xd8, 0x7c, 0x1a,
+	0x51, 0x62, 0xd1, 0xd3, 0xb3, 0xc4, 0x74, 0x27, 0x74, 0x97, 0xba, 0xa4, 0xd2, 0x82, 0xbf, 0x06,
+	0x0b, 0xdd, 0x28, 0x35, 0x9f, 0x3b, 0xd8, 0xc8, 0x6b, 0xfb, 0x7b, 0x87, 0x79, 0x35, 0x12, 0xe8,
+	0x65, 0x56, 0x6c, 0x2c, 0x58, 0xee, 0x97, 0x0f, 0xc1, 0xed, 0x6e, 0x82, 0x62, 0x29, 0xb3, 0x9b,
+	0xcb, 0x3e, 0x8d, 0x04, 0x63, 0x73, 0xa7, 0x67, 0x89, 0x99, 0x4e, 0x6c, 0xd1, 0x45, 0xb6, 0x51,
+	0x6e, 0xf1, 0x47, 0xd7, 0x8d, 0xdb, 0xca, 0x14, 0xb6, 0x23, 0xa1, 0xd8, 0xec, 0xe9, 0x59, 0x02,
+	0x76, 0x82, 0x78, 0x41, 0xc6, 0x42, 0xff, 0x78, 0x19, 0x1f, 0x58, 0xfe, 0x4e, 0x01, 0x53, 0x3d,
+	0xf6, 0x11, 0x58, 0x00, 0x77, 0x37, 0xf3, 0x79, 0x2d, 0x57, 0x28, 0x96, 0xd4, 0x42, 0xf6, 0x40,
+	0x10, 0xee, 0xec, 0xe5, 0xf2, 0x5d, 0x49, 0x4c, 0x9e, 0x9e, 0x25, 0xe2, 0x3d, 0xf0, 0x17, 0x33,
+	0xf9, 0x08, 0xcc, 0xf7, 0xa6, 0xca, 0xff, 0xee, 0x20, 0xb3, 0x1d, 0x51, 0x62, 0x0b, 0xa7, 0x67,
+	0x89, 0x68, 0x0f, 0x12, 0xb1, 0x60, 0x5c, 0x0d, 0xf7, 0x33, 0x7a, 0x15, 0x5c, 0x24, 0x54, 0x86,
+	0x99, 0x7d, 0xf4, 0xe6, 0x43, 0x5c, 0x79, 0xfb, 0x21, 0xae, 0x7c, 0xfb, 0x21, 0xae, 0x3c, 0xff,
+	0x18, 0x1f, 0x78, 0xfb, 0x31, 0x3e, 0xf0, 0xd5, 0xc7, 0xf8, 0xc0, 0xef, 0x7f, 0x52, 0x25, 0x6e,
+	0xad, 0x51, 0x4e, 0xe9, 0xd4, 0x4a, 0x5f, 0x18, 0xb2, 0x2b, 0x17, 0x7f, 0xb0, 0x97, 0x87, 0xc4,
+	0x63, 0x7f, 0xf0, 0xe3, 0x00, 0x8d, 0x31, 0xd2, 0xae, 0xd3, 0x0f, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AllowValidatorSelfExit != that1.AllowValidatorSelfExit {
+		return false
+	}
+	if this.HybridMode != that1.HybridMode {
+		return false
+	}
+	if !this.AdminPowerWeight.Equal(that1.AdminPowerWeight) {
+		return false
+	}
+	if this.MaxDelegatedPower != that1.MaxDelegatedPower {
+		return false
+	}
+	if len(this.BlockedMsgTypeUrls) != len(that1.BlockedMsgTypeUrls) {
+		return false
+	}
+	for i := range this.BlockedMsgTypeUrls {
+		if this.BlockedMsgTypeUrls[i] != that1.BlockedMsgTypeUrls[i] {
+			return false
+		}
+	}
+	if !this.CommissionLimits.Equal(&that1.CommissionLimits) {
+		return false
+	}
+	if !this.AccountAllowlist.Equal(&that1.AccountAllowlist) {
+		return false
+	}
+	if !this.EqualPower.Equal(&that1.EqualPower) {
+		return false
+	}
+	if !this.FeeDistribution.Equal(&that1.FeeDistribution) {
+		return false
+	}
+	if !this.VirtualPower.Equal(&that1.VirtualPower) {
+		return false
+	}
+	if !this.DowntimePolicy.Equal(&that1.DowntimePolicy) {
+		return false
+	}
+	return true
+}
+func (this *DowntimePolicyParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DowntimePolicyParams)
+	if !ok {
+		that2, ok := that.(DowntimePolicyParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Action != that1.Action {
+		return false
+	}
+	if !this.MinSignedPerWindow.Equal(that1.MinSignedPerWindow) {
+		return false
+	}
+	if !this.PowerReduction.Equal(that1.PowerReduction) {
+		return false
+	}
+	return true
+}
+func (this *VirtualPowerParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VirtualPowerParams)
+	if !ok {
+		that2, ok := that.(VirtualPowerParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	return true
+}
+func (this *FeeDistributionParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeDistributionParams)
+	if !ok {
+		that2, ok := that.(FeeDistributionParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Mode != that1.Mode {
+		return false
+	}
+	if this.TreasuryAddress != that1.TreasuryAddress {
+		return false
+	}
+	if !this.TreasuryShare.Equal(that1.TreasuryShare) {
+		return false
+	}
+	return true
+}
+func (this *EqualPowerParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EqualPowerParams)
+	if !ok {
+		that2, ok := that.(EqualPowerParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.Power != that1.Power {
+		return false
+	}
+	return true
+}
+func (this *AccountAllowlistParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountAllowlistParams)
+	if !ok {
+		that2, ok := that.(AccountAllowlistParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.AllowOperators != that1.AllowOperators {
+		return false
+	}
+	return true
+}
+func (this *CommissionLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommissionLimits)
+	if !ok {
+		that2, ok := that.(CommissionLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Rate.Equal(&that1.Rate) {
+		return false
+	}
+	if !this.MaxRate.Equal(&that1.MaxRate) {
+		return false
+	}
+	if !this.MaxChangeRate.Equal(&that1.MaxChangeRate) {
+		return false
+	}
+	return true
+}
+func (this *DecRange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DecRange)
+	if !ok {
+		that2, ok := that.(DecRange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Floor.Equal(that1.Floor) {
+		return false
+	}
+	if !this.Ceil.Equal(that1.Ceil) {
+		return false
+	}
+	return true
+}
+func (this *StakingParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StakingParams)
+	if !ok {
+		that2, ok := that.(StakingParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.UnbondingTime != that1.UnbondingTime {
+		return false
+	}
+	if this.MaxValidators != that1.MaxValidators {
+		return false
+	}
+	if this.MaxEntries != that1.MaxEntries {
+		return false
+	}
+	if this.HistoricalEntries != that1.HistoricalEntries {
+		return false
+	}
+	if this.BondDenom != that1.BondDenom {
+		return false
+	}
+	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+		return false
+	}
+	return true
+}
+func (this *SlashingParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SlashingParams)
+	if !ok {
+		that2, ok := that.(SlashingParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SignedBlocksWindow != that1.SignedBlocksWindow {
+		return false
+	}
+	if !this.MinSignedPerWindow.Equal(that1.MinSignedPerWindow) {
+		return false
+	}
+	if this.DowntimeJailDuration != that1.DowntimeJailDuration {
+		return false
+	}
+	if !this.SlashFractionDoubleSign.Equal(that1.SlashFractionDoubleSign) {
+		return false
+	}
+	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DowntimePolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.VirtualPower.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.FeeDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.EqualPower.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.AccountAllowlist.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.CommissionLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BlockedMsgTypeUrls) > 0 {
+		for iNdEx := len(m.BlockedMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.BlockedMsgTypeUrls[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.BlockedMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MaxDelegatedPower != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDelegatedPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.AdminPowerWeight.Size()
+		i -= size
+		if _, err := m.AdminPowerWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.HybridMode {
+		i--
+		if m.HybridMode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AllowValidatorSelfExit {
+		i--
+		if m.AllowValidatorSelfExit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DowntimePolicyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowntimePolicyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DowntimePolicyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PowerReduction.Size()
+		i -= size
+		if _, err := m.PowerReduction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Action != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VirtualPowerParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VirtualPowerParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VirtualPowerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDistributionParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDistributionParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDistributionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TreasuryShare.Size()
+		i -= size
+		if _, err := m.TreasuryShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TreasuryAddress) > 0 {
+		i -= len(m.TreasuryAddress)
+		copy(dAtA[i:], m.TreasuryAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.TreasuryAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Mode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EqualPowerParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EqualPowerParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EqualPowerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountAllowlistParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountAllowlistParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountAllowlistParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowOperators {
+		i--
+		if m.AllowOperators {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MaxChangeRate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.MaxRate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DecRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Ceil.Size()
+		i -= size
+		if _, err := m.Ceil.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Floor.Size()
+		i -= size
+		if _, err := m.Floor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StakingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.HistoricalEntries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HistoricalEntries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxEntries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxEntries))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxValidators != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxValidators))
+		i--
+		dAtA[i] = 0x10
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintParams(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashFractionDowntime.Size()
+		i -= size
+		if _, err := m.SlashFractionDowntime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SlashFractionDoubleSign.Size()
+		i -= size
+		if _, err := m.SlashFractionDoubleSign.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintParams(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AllowValidatorSelfExit {
+		n += 2
+	}
+	if m.HybridMode {
+		n += 2
+	}
+	l = m.AdminPowerWeight.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxDelegatedPower != 0 {
+		n += 1 + sovParams(uint64(m.MaxDelegatedPower))
+	}
+	if len(m.BlockedMsgTypeUrls) > 0 {
+		for _, s := range m.BlockedMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.CommissionLimits.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.AccountAllowlist.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.EqualPower.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FeeDistribution.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.VirtualPower.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.DowntimePolicy.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *DowntimePolicyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovParams(uint64(m.Action))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.PowerReduction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *VirtualPowerParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeDistributionParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovParams(uint64(m.Mode))
+	}
+	l = len(m.TreasuryAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.TreasuryShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *EqualPowerParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.Power != 0 {
+		n += 1 + sovParams(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *AccountAllowlistParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.AllowOperators {
+		n += 2
+	}
+	return n
+}
+
+func (m *CommissionLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *DecRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Floor.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Ceil.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *StakingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxValidators != 0 {
+		n += 1 + sovParams(uint64(m.MaxValidators))
+	}
+	if m.MaxEntries != 0 {
+		n += 1 + sovParams(uint64(m.MaxEntries))
+	}
+	if m.HistoricalEntries != 0 {
+		n += 1 + sovParams(uint64(m.HistoricalEntries))
+	}
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *SlashingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovParams(uint64(m.SignedBlocksWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SlashFractionDoubleSign.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SlashFractionDowntime.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowValidatorSelfExit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowValidatorSelfExit = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HybridMode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HybridMode = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminPowerWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdminPowerWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegatedPower", wireType)
+			}
+			m.MaxDelegatedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelegatedPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedMsgTypeUrls = append(m.BlockedMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAllowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccountAllowlist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EqualPower", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EqualPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualPower", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VirtualPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DowntimePolicyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DowntimePolicyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DowntimePolicyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= DowntimeAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerReduction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PowerReduction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VirtualPowerParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VirtualPowerParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VirtualPowerParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDistributionParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDistributionParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDistributionParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= FeeDistributionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TreasuryShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EqualPowerParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EqualPowerParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EqualPowerParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountAllowlistParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountAllowlistParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountAllowlistParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowOperators", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowOperators = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Floor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Floor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ceil", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ceil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	i -= n1
-	i = encodeVarintParams(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *StakingParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime)
-	n += 1 + l + sovParams(uint64(l))
-	if m.MaxValidators != 0 {
-		n += 1 + sovParams(uint64(m.MaxValidators))
-	}
-	if m.MaxEntries != 0 {
-		n += 1 + sovParams(uint64(m.MaxEntries))
-	}
-	if m.HistoricalEntries != 0 {
-		n += 1 + sovParams(uint64(m.HistoricalEntries))
-	}
-	l = len(m.BondDenom)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.MinCommissionRate.Size()
-	n += 1 + l + sovParams(uint64(l))
-	return n
-}
-
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
 func (m *StakingParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -509,6 +3420,210 @@ func (m *StakingParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SlashingParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashingParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,6 +6,7 @@ package poa
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -287,471 +288,4397 @@ func (m *QueryPoaAuthorityResponse) GetAuthority() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryPendingValidatorsRequest)(nil), "strangelove_ventures.poa.v1.QueryPendingValidatorsRequest")
-	proto.RegisterType((*PendingValidatorsResponse)(nil), "strangelove_ventures.poa.v1.PendingValidatorsResponse")
-	proto.RegisterType((*QueryConsensusPowerRequest)(nil), "strangelove_ventures.poa.v1.QueryConsensusPowerRequest")
-	proto.RegisterType((*QueryConsensusPowerResponse)(nil), "strangelove_ventures.poa.v1.QueryConsensusPowerResponse")
-	proto.RegisterType((*QueryPoaAuthorityRequest)(nil), "strangelove_ventures.poa.v1.QueryPoaAuthorityRequest")
-	proto.RegisterType((*QueryPoaAuthorityResponse)(nil), "strangelove_ventures.poa.v1.QueryPoaAuthorityResponse")
+// QueryOrganizationsRequest is the request type for the Query/Organizations RPC method.
+type QueryOrganizationsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("strangelove_ventures/poa/v1/query.proto", fileDescriptor_676fcce3868e4c52)
+func (m *QueryOrganizationsRequest) Reset()         { *m = QueryOrganizationsRequest{} }
+func (m *QueryOrganizationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOrganizationsRequest) ProtoMessage()    {}
+func (*QueryOrganizationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_676fcce3868e4c52, []int{6}
 }
-
-var fileDescriptor_676fcce3868e4c52 = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4d, 0x6b, 0x13, 0x41,
-	0x1c, 0xc6, 0x33, 0xc6, 0x17, 0x3a, 0x4a, 0x35, 0xa3, 0x60, 0xb2, 0x8d, 0x9b, 0x32, 0x82, 0x0d,
-	0x14, 0x77, 0x69, 0xc5, 0x57, 0xf0, 0xd0, 0x0a, 0x05, 0x6f, 0x35, 0x07, 0x0f, 0x5e, 0xc2, 0x74,
-	0x77, 0xd8, 0x2e, 0xa4, 0xf3, 0x9f, 0xce, 0xcc, 0xae, 0xf4, 0xea, 0x27, 0x10, 0xbc, 0xf8, 0x01,
-	0xbc, 0xf9, 0x45, 0x7a, 0x2c, 0x78, 0xf1, 0x24, 0x92, 0xf8, 0x1d, 0xbc, 0xca, 0xbe, 0xcc, 0x36,
-	0xb5, 0xdb, 0x8d, 0xde, 0xc2, 0x3c, 0xff, 0xe7, 0x3f, 0xbf, 0x3c, 0xcf, 0x0e, 0x5e, 0xd3, 0x46,
-	0x31, 0x11, 0xf1, 0x09, 0xa4, 0x7c, 0x9c, 0x72, 0x61, 0x12, 0xc5, 0xb5, 0x2f, 0x81, 0xf9, 0xe9,
-	0x86, 0x7f, 0x98, 0x70, 0x75, 0xe4, 0x49, 0x05, 0x06, 0xc8, 0x4a, 0xdd, 0xa0, 0x27, 0x81, 0x79,
-	0xe9, 0x86, 0x73, 0x27, 0x82, 0x08, 0xf2, 0x39, 0x3f, 0xfb, 0x55, 0x58, 0x9c, 0x7e, 0x04, 0x10,
-	0x4d, 0xb8, 0xcf, 0x64, 0xec, 0x33, 0x21, 0xc0, 0x30, 0x13, 0x83, 0xd0, 0xa5, 0x3a, 0x6c, 0xba,
-	0x59, 0x32, 0xc5, 0x0e, 0xec, 0xe4, 0x7a, 0xd3, 0x64, 0xca, 0x26, 0x71, 0xc8, 0x0c, 0xa8, 0x62,
-	0x98, 0x0e, 0xf0, 0xbd, 0x37, 0x19, 0xf6, 0x2e, 0x17, 0x61, 0x2c, 0xa2, 0xb7, 0x56, 0xd6, 0x23,
-	0x7e, 0x98, 0x70, 0x6d, 0x68, 0x80, 0x7b, 0x35, 0x9a, 0x96, 0x20, 0x34, 0x27, 0x3b, 0xf8, 0x9a,
-	0x2c, 0xc4, 0x2e, 0x5a, 0x6d, 0x0f, 0xaf, 0x6f, 0x3e, 0xf0, 0x1a, 0xfe, 0xb7, 0x57, 0x6d, 0xd8,
-	0xbe, 0x7c, 0xfc, 0x63, 0xd0, 0x1a, 0x59, 0x33, 0x7d, 0x8d, 0x9d, 0x9c, 0xe2, 0x55, 0xb6, 0x55,
-	0xe8, 0x44, 0xef, 0xc2, 0x7b, 0xae, 0x4a, 0x04, 0xb2, 0x8e, 0x3b, 0x15, 0xf6, 0x98, 0x85, 0xa1,
-	0xe2, 0x5a, 0x77, 0xd1, 0x2a, 0x1a, 0x2e, 0x8d, 0x6e, 0x55, 0xc2, 0x56, 0x71, 0x4e, 0x77, 0xf0,
-	0x4a, 0xed, 0xaa, 0x92, 0x78, 0x0d, 0xdf, 0x0c, 0xac, 0x32, 0x96, 0x99, 0x94, 0x6f, 0x6a, 0x8f,
-	0x96, 0x83, 0x33, 0x06, 0xea, 0xe0, 0x6e, 0x11, 0x0c, 0xb0, 0xad, 0xc4, 0xec, 0x83, 0x8a, 0xcd,
-	0x91, 0xcd, 0xe4, 0x39, 0xee, 0xd5, 0x68, 0xe5, 0x0d, 0x7d, 0xbc, 0xc4, 0xec, 0x61, 0x49, 0x79,
-	0x7a, 0xb0, 0xf9, 0xbb, 0x8d, 0xaf, 0xe4, 0x5e, 0xf2, 0x15, 0xe1, 0xce, 0xb9, 0x64, 0xc9, 0x8b,
-	0xc6, 0x00, 0x1b, 0xab, 0x72, 0x9e, 0x34, 0x7a, 0x2f, 0x6c, 0x91, 0xd2, 0x0f, 0xdf, 0x7e, 0x7d,
-	0xba, 0xd4, 0x27, 0x4e, 0xf5, 0x39, 0x15, 0xa3, 0xe3, 0xf4, 0x94, 0xeb, 0x0b, 0xc2, 0xcb, 0x67,
-	0x23, 0x25, 0x4f, 0x17, 0xa3, 0xd6, 0xf6, 0xe9, 0x3c, 0xfb, 0x7f, 0x63, 0x49, 0x3a, 0xc8, 0x49,
-	0x7b, 0xe4, 0xae, 0x25, 0xfd, 0xab, 0x4b, 0xf2, 0x19, 0xe1, 0x1b, 0xf3, 0xad, 0x90, 0xc7, 0xff,
-	0x90, 0xe7, 0xf9, 0x86, 0x17, 0x44, 0x79, 0x61, 0xf9, 0xb4, 0x97, 0x03, 0xde, 0x26, 0x1d, 0x0b,
-	0x58, 0x35, 0xbf, 0xfd, 0xf2, 0x78, 0xea, 0xa2, 0x93, 0xa9, 0x8b, 0x7e, 0x4e, 0x5d, 0xf4, 0x71,
-	0xe6, 0xb6, 0x4e, 0x66, 0x6e, 0xeb, 0xfb, 0xcc, 0x6d, 0xbd, 0xbb, 0x1f, 0xc5, 0x66, 0x3f, 0xd9,
-	0xf3, 0x02, 0x38, 0xf0, 0xe7, 0xae, 0x7d, 0x38, 0xff, 0x76, 0xf7, 0xae, 0xe6, 0xef, 0xf5, 0xd1,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x54, 0x0a, 0xb4, 0xfe, 0x82, 0x04, 0x00, 0x00,
+func (m *QueryOrganizationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrganizationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrganizationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOrganizationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrganizationsRequest.Merge(m, src)
+}
+func (m *QueryOrganizationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrganizationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrganizationsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryOrganizationsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// PendingValidators returns currently pending validators of the module.
-	PendingValidators(ctx context.Context, in *QueryPendingValidatorsRequest, opts ...grpc.CallOption) (*PendingValidatorsResponse, error)
-	// ConsensusPower returns the current consensus power of a validator.
-	ConsensusPower(ctx context.Context, in *QueryConsensusPowerRequest, opts ...grpc.CallOption) (*QueryConsensusPowerResponse, error)
-	// POA Authority
-	PoaAuthority(ctx context.Context, in *QueryPoaAuthorityRequest, opts ...grpc.CallOption) (*QueryPoaAuthorityResponse, error)
+func (m *QueryOrganizationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryOrganizationsResponse is the response type for the Query/Organizations RPC method.
+type QueryOrganizationsResponse struct {
+	// organizations are the registered organizations.
+	Organizations []Organization `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryOrganizationsResponse) Reset()         { *m = QueryOrganizationsResponse{} }
+func (m *QueryOrganizationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOrganizationsResponse) ProtoMessage()    {}
+func (*QueryOrganizationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_676fcce3868e4c52, []int{7}
 }
-
-func (c *queryClient) PendingValidators(ctx context.Context, in *QueryPendingValidatorsRequest, opts ...grpc.CallOption) (*PendingValidatorsResponse, error) {
-	out := new(PendingValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/strangelove_ventures.poa.v1.Query/PendingValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOrganizationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrganizationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrganizationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryOrganizationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrganizationsResponse.Merge(m, src)
+}
+func (m *QueryOrganizationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrganizationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrganizationsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ConsensusPower(ctx context.Context, in *QueryConsensusPowerRequest, opts ...grpc.CallOption) (*QueryConsensusPowerResponse, error) {
-	out := new(QueryConsensusPowerResponse)
-	err := c.cc.Invoke(ctx, "/strangelove_ventures.poa.v1.Query/ConsensusPower", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOrganizationsResponse proto.InternalMessageInfo
+
+func (m *QueryOrganizationsResponse) GetOrganizations() []Organization {
+	if m != nil {
+		return m.Organizations
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) PoaAuthority(ctx context.Context, in *QueryPoaAuthorityRequest, opts ...grpc.CallOption) (*QueryPoaAuthorityResponse, error) {
-	out := new(QueryPoaAuthorityResponse)
-	err := c.cc.Invoke(ctx, "/strangelove_ventures.poa.v1.Query/PoaAuthority", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOrganizationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// PendingValidators returns currently pending validators of the module.
-	PendingValidators(context.Context, *QueryPendingValidatorsRequest) (*PendingValidatorsResponse, error)
-	// ConsensusPower returns the current consensus power of a validator.
-	ConsensusPower(context.Context, *QueryConsensusPowerRequest) (*QueryConsensusPowerResponse, error)
-	// POA Authority
-	PoaAuthority(context.Context, *QueryPoaAuthorityRequest) (*QueryPoaAuthorityResponse, error)
+// QueryOrganizationPowerRequest is the request type for the Query/OrganizationPower RPC method.
+type QueryOrganizationPowerRequest struct {
+	// organization_id is the id of the organization
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryOrganizationPowerRequest) Reset()         { *m = QueryOrganizationPowerRequest{} }
+func (m *QueryOrganizationPowerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOrganizationPowerRequest) ProtoMessage()    {}
+func (*QueryOrganizationPowerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_676fcce3868e4c52, []int{8}
 }
-
-func (*UnimplementedQueryServer) PendingValidators(ctx context.Context, req *QueryPendingValidatorsRequest) (*PendingValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingValidators not implemented")
+func (m *QueryOrganizationPowerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ConsensusPower(ctx context.Context, req *QueryConsensusPowerRequest) (*QueryConsensusPowerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConsensusPower not implemented")
+func (m *QueryOrganizationPowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrganizationPowerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) PoaAuthority(ctx context.Context, req *QueryPoaAuthorityRequest) (*QueryPoaAuthorityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoaAuthority not implemented")
+func (m *QueryOrganizationPowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrganizationPowerRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryOrganizationPowerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrganizationPowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrganizationPowerRequest.DiscardUnknown(m)
 }
 
-func _Query_PendingValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPendingValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/strangelove_ventures.poa.v1.Query/PendingValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingValidators(ctx, req.(*QueryPendingValidatorsRequest))
+var xxx_messageInfo_QueryOrganizationPowerRequest proto.InternalMessageInfo
+
+func (m *QueryOrganizationPowerRequest) GetOrganizationId() string {
+	if m != nil {
+		return m.OrganizationId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ConsensusPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsensusPowerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ConsensusPower(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/strangelove_ventures.poa.v1.Query/ConsensusPower",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ConsensusPower(ctx, req.(*QueryConsensusPowerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryOrganizationPowerResponse is the response type for the Query/OrganizationPower RPC method.
+type QueryOrganizationPowerResponse struct {
+	// organization is the requested organization
+	Organization Organization `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization"`
+	// validators are the operator addresses assigned to the organization
+	Validators []string `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
+	// consensus_power is the combined consensus power of the organization's validators
+	ConsensusPower int64 `protobuf:"varint,3,opt,name=consensus_power,json=consensusPower,proto3" json:"consensus_power,omitempty"`
+	// total_consensus_power is the total consensus power of the network
+	TotalConsensusPower int64 `protobuf:"varint,4,opt,name=total_consensus_power,json=totalConsensusPower,proto3" json:"total_consensus_power,omitempty"`
 }
 
-func _Query_PoaAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoaAuthorityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoaAuthority(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/strangelove_ventures.poa.v1.Query/PoaAuthority",
+func (m *QueryOrganizationPowerResponse) Reset()         { *m = QueryOrganizationPowerResponse{} }
+func (m *QueryOrganizationPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOrganizationPowerResponse) ProtoMessage()    {}
+func (*QueryOrganizationPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_676fcce3868e4c52, []int{9}
+}
+func (m *QueryOrganizationPowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrganizationPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrganizationPowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoaAuthority(ctx, req.(*QueryPoaAuthorityRequest))
+}
+func (m *QueryOrganizationPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrganizationPowerResponse.Merge(m, src)
+}
+func (m *QueryOrganizationPowerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrganizationPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrganizationPowerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOrganizationPowerResponse proto.InternalMessageInfo
+
+func (m *QueryOrganizationPowerResponse) GetOrganization() Organization {
+	if m != nil {
+		return m.Organization
 	}
-	return interceptor(ctx, in, info, handler)
+	return Organization{}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "strangelove_ventures.poa.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "PendingValidators",
-			Handler:    _Query_PendingValidators_Handler,
-		},
-		{
-			MethodName: "ConsensusPower",
-			Handler:    _Query_ConsensusPower_Handler,
-		},
-		{
-			MethodName: "PoaAuthority",
-			Handler:    _Query_PoaAuthority_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "strangelove_ventures/poa/v1/query.proto",
+func (m *QueryOrganizationPowerResponse) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
 }
 
-func (m *QueryPendingValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOrganizationPowerResponse) GetConsensusPower() int64 {
+	if m != nil {
+		return m.ConsensusPower
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryPendingValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryOrganizationPowerResponse) GetTotalConsensusPower() int64 {
+	if m != nil {
+		return m.TotalConsensusPower
+	}
+	return 0
 }
 
-func (m *QueryPendingValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryAuditLogRequest is the request type for the Query/AuditLog RPC method.
+type QueryAuditLogRequest struct {
+	// action filters the entries by action type, if set.
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// validator_address filters the entries by validator, if set.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *PendingValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAuditLogRequest) Reset()         { *m = QueryAuditLogRequest{} }
+func (m *QueryAuditLogRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuditLogRequest) ProtoMessage()    {}
+func (*QueryAuditLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_676fcce3868e4c52, []int{10}
+}
+func (m *QueryAuditLogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuditLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuditLogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *PendingValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAuditLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuditLogRequest.Merge(m, src)
+}
+func (m *QueryAuditLogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuditLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuditLogRequest.DiscardUnknown(m)
 }
 
-func (m *PendingValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pending) > 0 {
-		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryAuditLogRequest proto.InternalMessageInfo
+
+func (m *QueryAuditLogRequest) GetAction() string {
+	if m != nil {
+		return m.Action
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryConsensusPowerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAuditLogRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryConsensusPowerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAuditLogRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryConsensusPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryAuditLogResponse is the response type for the Query/AuditLog RPC method.
+type QueryAuditLogResponse struct {
+	// entries are the matching audit log entries.
+	Entries []AuditLogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryConsensusPowerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAuditLogResponse) Reset()         { *m = QueryAuditLogResponse{} }
+func (m *QueryAuditLogResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuditLogResponse) ProtoMessage()    {}
+func (*QueryAuditLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_676fcce3868e4c52, []int{11}
+}
+func (m *QueryAuditLogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuditLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuditLogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryConsensusPowerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAuditLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuditLogResponse.Merge(m, src)
+}
+func (m *QueryAuditLogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuditLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuditLogResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsensusPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ConsensusPower != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ConsensusPower))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryAuditLogResponse proto.InternalMessageInfo
+
+func (m *QueryAuditLogResponse) GetEntries() []AuditLogEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryPoaAuthorityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAuditLogResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPoaAuthorityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
 }
 
-func (m *QueryPoaAuthorityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_676fcce3868e4c52, []int{12}
 }
-
-func (m *QueryPoaAuthorityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPoaAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params are the module parameters.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryPoaAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_676fcce3868e4c52, []int{13}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return Params{}
 }
-func (m *QueryPendingValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+// QueryAccountAllowlistRequest is the request type for the Query/AccountAllowlist RPC method.
+type QueryAccountAllowlistRequest struct {
+	// pagination defines an optional pagination for the accounts.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *PendingValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pending) > 0 {
-		for _, e := range m.Pending {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAccountAllowlistRequest) Reset()         { *m = QueryAccountAllowlistRequest{} }
+func (m *QueryAccountAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountAllowlistRequest) ProtoMessage()    {}
+func (*QueryAccountAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_676fcce3868e4c52, []int{14}
+}
+func (m *QueryAccountAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryAccountAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountAllowlistRequest.Merge(m, src)
+}
+func (m *QueryAccountAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountAllowlistRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsensusPowerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryAccountAllowlistRequest proto.InternalMessageInfo
+
+func (m *QueryAccountAllowlistRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryConsensusPowerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryAccountAllowlistResponse is the response type for the Query/AccountAllowlist RPC method.
+type QueryAccountAllowlistResponse struct {
+	// accounts are the allowed account addresses.
+	Accounts []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// prefixes are the allowed address prefixes.
+	Prefixes []string `protobuf:"bytes,2,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+	// pagination defines the pagination of the accounts in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountAllowlistResponse) Reset()         { *m = QueryAccountAllowlistResponse{} }
+func (m *QueryAccountAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountAllowlistResponse) ProtoMessage()    {}
+func (*QueryAccountAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_676fcce3868e4c52, []int{15}
+}
+func (m *QueryAccountAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.ConsensusPower != 0 {
-		n += 1 + sovQuery(uint64(m.ConsensusPower))
+}
+func (m *QueryAccountAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountAllowlistResponse.Merge(m, src)
+}
+func (m *QueryAccountAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountAllowlistResponse proto.InternalMessageInfo
+
+func (m *QueryAccountAllowlistResponse) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
 	}
-	return n
+	return nil
 }
 
-func (m *QueryPoaAuthorityRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAccountAllowlistResponse) GetPrefixes() []string {
+	if m != nil {
+		return m.Prefixes
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryPoaAuthorityResponse) Size() (n int) {
+func (m *QueryAccountAllowlistResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMaintenanceModeRequest is the request type for the Query/MaintenanceMode RPC method.
+type QueryMaintenanceModeRequest struct {
+}
+
+func (m *QueryMaintenanceModeRequest) Reset()         { *m = QueryMaintenanceModeRequest{} }
+func (m *QueryMaintenanceModeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMaintenanceModeRequest) ProtoMessage()    {}
+func (*QueryMaintenanceModeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_676fcce3868e4c52, []int{16}
+}
+func (m *QueryMaintenanceModeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaintenanceModeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaintenanceModeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMaintenanceModeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaintenanceModeRequest.Merge(m, src)
+}
+func (m *QueryMaintenanceModeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaintenanceModeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaintenanceModeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMaintenanceModeRequest proto.InternalMessageInfo
+
+// QueryMaintenanceModeResponse is the response type for the Query/MaintenanceMode RPC method.
+type QueryMaintenanceModeResponse struct {
+	// maintenance_mode is the stored maintenance mode.
+	MaintenanceMode MaintenanceMode `protobuf:"bytes,1,opt,name=maintenance_mode,json=maintenanceMode,proto3" json:"maintenance_mode"`
+	// active is true when maintenance mode is enabled and not expired.
+	Active bool `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (m *QueryMaintenanceModeResponse) Reset()         { *m = QueryMaintenanceModeResponse{} }
+func (m *QueryMaintenanceModeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMaintenanceModeResponse) ProtoMessage()    {}
+func (*QueryMaintenanceModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_676fcce3868e4c52, []int{17}
+}
+func (m *QueryMaintenanceModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaintenanceModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaintenanceModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMaintenanceModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaintenanceModeResponse.Merge(m, src)
+}
+func (m *QueryMaintenanceModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaintenanceModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaintenanceModeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMaintenanceModeResponse proto.InternalMessageInfo
+
+func (m *QueryMaintenanceModeResponse) GetMaintenanceMode() MaintenanceMode {
+	if m != nil {
+		return m.MaintenanceMode
+	}
+	return MaintenanceMode{}
+}
+
+func (m *QueryMaintenanceModeResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+// QueryStatusRequest is the request type for the Query/Status RPC method.
+type QueryStatusRequest struct {
+}
+
+func (m *QueryStatusRequest) Reset()         { *m = QueryStatusRequest{} }
+func (m *QueryStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStatusRequest) ProtoMessage()    {}
+func (*QueryStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_676fcce3868e4c52, []int{18}
+}
+func (m *QueryStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatusRequest.Merge(m, src)
+}
+func (m *QueryStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStatusRequest proto.InternalMessageInfo
+
+// QueryStatusResponse is the response type for the Query/Status RPC method.
+type QueryStatusResponse struct {
+	// paused is true when the validator set operations are paused.
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// guardian is the address allowed to pause the module, empty if not set.
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+}
+
+func (m *QueryStatusResponse) Reset()         { *m = QueryStatusResponse{} }
+func (m *QueryStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStatusResponse) ProtoMessage()    {}
+func (*QueryStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_676fcce3868e4c52, []int{19}
+}
+func (m *QueryStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatusResponse.Merge(m, src)
+}
+func (m *QueryStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStatusResponse proto.InternalMessageInfo
+
+func (m *QueryStatusResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *QueryStatusResponse) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+// QueryFeeRecipientRequest is the request type for the Query/FeeRecipient RPC method.
+type QueryFeeRecipientRequest struct {
+	// address is the account address of the recipient.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryFeeRecipientRequest) Reset()         { *m = QueryFeeRecipientRequest{} }
+func (m *QueryFeeRecipientRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeRecipientRequest) ProtoMessage()    {}
+func (*QueryFeeRecipientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_676fcce3868e4c52, []int{20}
+}
+func (m *QueryFeeRecipientRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeRecipientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeRecipientRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeRecipientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeRecipientRequest.Merge(m, src)
+}
+func (m *QueryFeeRecipientRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeRecipientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeRecipientRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeRecipientRequest proto.InternalMessageInfo
+
+func (m *QueryFeeRecipientRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryFeeRecipientResponse is the response type for the Query/FeeRecipient RPC method.
+type QueryFeeRecipientResponse struct {
+	// fee_recipient are the accrued and paid fees of the account.
+	FeeRecipient FeeRecipient `protobuf:"bytes,1,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient"`
+}
+
+func (m *QueryFeeRecipientResponse) Reset()         { *m = QueryFeeRecipientResponse{} }
+func (m *QueryFeeRecipientResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeRecipientResponse) ProtoMessage()    {}
+func (*QueryFeeRecipientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_676fcce3868e4c52, []int{21}
+}
+func (m *QueryFeeRecipientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeRecipientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeRecipientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeRecipientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeRecipientResponse.Merge(m, src)
+}
+func (m *QueryFeeRecipientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeRecipientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeRecipientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeRecipientResponse proto.InternalMessageInfo
+
+func (m *QueryFeeRecipientResponse) GetFeeRecipient() FeeRecipient {
+	if m != nil {
+		return m.FeeRecipient
+	}
+	return FeeRecipient{}
+}
+
+// QueryFeeRecipientsRequest is the request type for the Query/FeeRecipients RPC method.
+type QueryFeeRecipientsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeRecipientsRequest) Reset()         { *m = QueryFeeRecipientsRequest{} }
+func (m *QueryFeeRecipientsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeRecipientsRequest) ProtoMessage()    {}
+func (*QueryFeeRecipientsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_676fcce3868e4c52, []int{22}
+}
+func (m *QueryFeeRecipientsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeRecipientsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeRecipientsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeRecipientsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeRecipientsRequest.Merge(m, src)
+}
+func (m *QueryFeeRecipientsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeRecipientsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeRecipientsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeRecipientsRequest proto.InternalMessageInfo
+
+func (m *QueryFeeRecipientsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFeeRecipientsResponse is the response type for the Query/FeeRecipients RPC method.
+type QueryFeeRecipientsResponse struct {
+	// fee_recipients are the accrued and paid fees of the accounts.
+	FeeRecipients []FeeRecipient `protobuf:"bytes,1,rep,name=fee_recipients,json=feeRecipients,proto3" json:"fee_recipients"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeRecipientsResponse) Reset()         { *m = QueryFeeRecipientsResponse{} }
+func (m *QueryFeeRecipientsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeRecipientsResponse) ProtoMessage()    {}
+func (*QueryFeeRecipientsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_676fcce3868e4c52, []int{23}
+}
+func (m *QueryFeeRecipientsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeRecipientsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeRecipientsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeRecipientsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeRecipientsResponse.Merge(m, src)
+}
+func (m *QueryFeeRecipientsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeRecipientsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeRecipientsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeRecipientsResponse proto.InternalMessageInfo
+
+func (m *QueryFeeRecipientsResponse) GetFeeRecipients() []FeeRecipient {
+	if m != nil {
+		return m.FeeRecipients
+	}
+	return nil
+}
+
+func (m *QueryFeeRecipientsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryPendingValidatorsRequest)(nil), "strangelove_ventures.poa.v1.QueryPendingValidatorsRequest")
+	proto.RegisterType((*PendingValidatorsResponse)(nil), "strangelove_ventures.poa.v1.PendingValidatorsResponse")
+	proto.RegisterType((*QueryConsensusPowerRequest)(nil), "strangelove_ventures.poa.v1.QueryConsensusPowerRequest")
+	proto.RegisterType((*QueryConsensusPowerResponse)(nil), "strangelove_ventures.poa.v1.QueryConsensusPowerResponse")
+	proto.RegisterType((*QueryPoaAuthorityRequest)(nil), "strangelove_ventures.poa.v1.QueryPoaAuthorityRequest")
+	proto.RegisterType((*QueryPoaAuthorityResponse)(nil), "strangelove_ventures.poa.v1.QueryPoaAuthorityResponse")
+	proto.RegisterType((*QueryOrganizationsRequest)(nil), "strangelove_ventures.poa.v1.QueryOrganizationsRequest")
+	proto.RegisterType((*QueryOrganizationsResponse)(nil), "strangelove_ventures.poa.v1.QueryOrganizationsResponse")
+	proto.RegisterType((*QueryOrganizationPowerRequest)(nil), "strangelove_ventures.poa.v1.QueryOrganizationPowerRequest")
+	proto.RegisterType((*QueryOrganizationPowerResponse)(nil), "strangelove_ventures.poa.v1.QueryOrganizationPowerResponse")
+	proto.RegisterType((*QueryAuditLogRequest)(nil), "strangelove_ventures.poa.v1.QueryAuditLogRequest")
+	proto.RegisterType((*QueryAuditLogResponse)(nil), "strangelove_ventures.poa.v1.QueryAuditLogResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "strangelove_ventures.poa.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "strangelove_ventures.poa.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryAccountAllowlistRequest)(nil), "strangelove_ventures.poa.v1.QueryAccountAllowlistRequest")
+	proto.RegisterType((*QueryAccountAllowlistResponse)(nil), "strangelove_ventures.poa.v1.QueryAccountAllowlistResponse")
+	proto.RegisterType((*QueryMaintenanceModeRequest)(nil), "strangelove_ventures.poa.v1.QueryMaintenanceModeRequest")
+	proto.RegisterType((*QueryMaintenanceModeResponse)(nil), "strangelove_ventures.poa.v1.QueryMaintenanceModeResponse")
+	proto.RegisterType((*QueryStatusRequest)(nil), "strangelove_ventures.poa.v1.QueryStatusRequest")
+	proto.RegisterType((*QueryStatusResponse)(nil), "strangelove_ventures.poa.v1.QueryStatusResponse")
+	proto.RegisterType((*QueryFeeRecipientRequest)(nil), "strangelove_ventures.poa.v1.QueryFeeRecipientRequest")
+	proto.RegisterType((*QueryFeeRecipientResponse)(nil), "strangelove_ventures.poa.v1.QueryFeeRecipientResponse")
+	proto.RegisterType((*QueryFeeRecipientsRequest)(nil), "strangelove_ventures.poa.v1.QueryFeeRecipientsRequest")
+	proto.RegisterType((*QueryFeeRecipientsResponse)(nil), "strangelove_ventures.poa.v1.QueryFeeRecipientsResponse")
+}
+
+func init() {
+	proto.RegisterFile("strangelove_ventures/poa/v1/query.proto", fileDescriptor_676fcce3868e4c52)
+}
+
+var fileDescriptor_676fcce3868e4c52 = []byte{
+	// 1292 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x93, 0x92, 0x8f, 0x47, 0x3e, 0x27, 0x1f, 0xdd, 0x75, 0x92, 0x4d, 0x70, 0x25, 0x12,
+	0x5a, 0xba, 0xdb, 0x04, 0x48, 0x49, 0x11, 0x87, 0x14, 0x91, 0x12, 0x44, 0x45, 0xd8, 0x42, 0x85,
+	0x90, 0xd0, 0x6a, 0xb2, 0x9e, 0xb8, 0x96, 0x76, 0x3d, 0x1b, 0x7b, 0xbc, 0x25, 0x20, 0x2e, 0x70,
+	0x45, 0x08, 0x09, 0x21, 0x71, 0xa3, 0x12, 0x48, 0x20, 0xd1, 0x03, 0x7f, 0x00, 0xdc, 0x7b, 0xac,
+	0xc4, 0x85, 0x13, 0x42, 0x09, 0x7f, 0x01, 0x7f, 0x01, 0xf2, 0xf8, 0xd9, 0x19, 0x6f, 0xbc, 0x8e,
+	0x37, 0xca, 0x6d, 0x67, 0xe6, 0xbd, 0x79, 0xbf, 0xf7, 0x31, 0xef, 0xfd, 0xbc, 0xb0, 0xe2, 0x09,
+	0x97, 0x3a, 0x16, 0x6b, 0xf0, 0x36, 0xab, 0xb5, 0x99, 0x23, 0x7c, 0x97, 0x79, 0x95, 0x16, 0xa7,
+	0x95, 0xf6, 0x5a, 0xe5, 0xc0, 0x67, 0xee, 0x61, 0xb9, 0xe5, 0x72, 0xc1, 0xc9, 0x7c, 0x9a, 0x60,
+	0xb9, 0xc5, 0x69, 0xb9, 0xbd, 0xa6, 0xcf, 0x58, 0xdc, 0xe2, 0x52, 0xae, 0x12, 0xfc, 0x0a, 0x55,
+	0xf4, 0x05, 0x8b, 0x73, 0xab, 0xc1, 0x2a, 0xb4, 0x65, 0x57, 0xa8, 0xe3, 0x70, 0x41, 0x85, 0xcd,
+	0x1d, 0x0f, 0x4f, 0x57, 0xb3, 0x2c, 0xb7, 0xa8, 0x4b, 0x9b, 0x91, 0xe4, 0xb5, 0x2c, 0xc9, 0x36,
+	0x6d, 0xd8, 0x26, 0x15, 0xdc, 0x45, 0xe1, 0x72, 0x96, 0x30, 0x77, 0x2d, 0xea, 0xd8, 0x9f, 0x4a,
+	0x1c, 0x28, 0x9f, 0x19, 0x00, 0xea, 0x9b, 0xb6, 0x40, 0xc1, 0xeb, 0x59, 0x82, 0x4d, 0x6a, 0x3b,
+	0x82, 0x39, 0xd4, 0xa9, 0x33, 0x14, 0x5f, 0xcf, 0x12, 0xdf, 0x67, 0xac, 0x66, 0xda, 0x9e, 0x70,
+	0xed, 0x3d, 0x5f, 0xc1, 0x72, 0xb5, 0xce, 0xbd, 0x26, 0xf7, 0x2a, 0x7b, 0xd4, 0x63, 0x61, 0xf0,
+	0x2b, 0xed, 0xb5, 0x3d, 0x26, 0x68, 0x10, 0x10, 0xcb, 0x76, 0x14, 0xdc, 0xc6, 0x12, 0x2c, 0xbe,
+	0x17, 0x48, 0xec, 0x32, 0xc7, 0xb4, 0x1d, 0xeb, 0x7e, 0x14, 0x06, 0xaf, 0xca, 0x0e, 0x7c, 0xe6,
+	0x09, 0xa3, 0x0e, 0xc5, 0x94, 0x33, 0xaf, 0xc5, 0x1d, 0x8f, 0x91, 0x6d, 0x18, 0x6a, 0x85, 0x87,
+	0x05, 0x6d, 0x79, 0x60, 0xf5, 0xd9, 0xf5, 0xe7, 0xcb, 0x19, 0xf9, 0x2d, 0xc7, 0x37, 0xdc, 0xbe,
+	0xf4, 0xe4, 0xef, 0xa5, 0xbe, 0x6a, 0xa4, 0x6c, 0xec, 0x80, 0x2e, 0x51, 0xbc, 0x11, 0xdc, 0xea,
+	0x78, 0xbe, 0xb7, 0xcb, 0x1f, 0x32, 0x17, 0x21, 0x90, 0x6b, 0x30, 0x15, 0xa7, 0xa7, 0x46, 0x4d,
+	0xd3, 0x65, 0x9e, 0x57, 0xd0, 0x96, 0xb5, 0xd5, 0x91, 0xea, 0x64, 0x7c, 0xb0, 0x15, 0xee, 0x1b,
+	0xdb, 0x30, 0x9f, 0x7a, 0x15, 0x22, 0x5e, 0x81, 0x89, 0x7a, 0x74, 0x52, 0x6b, 0x05, 0x47, 0xf2,
+	0xa6, 0x81, 0xea, 0x78, 0x3d, 0xa1, 0x60, 0xe8, 0x50, 0x08, 0x03, 0xc3, 0xe9, 0x96, 0x2f, 0x1e,
+	0x70, 0xd7, 0x16, 0x87, 0x51, 0x4c, 0x36, 0xa1, 0x98, 0x72, 0x86, 0x16, 0x16, 0x60, 0x84, 0x46,
+	0x9b, 0x88, 0xf2, 0x64, 0xc3, 0xa8, 0xa3, 0xea, 0xbb, 0x4a, 0x09, 0x45, 0xb1, 0x26, 0xdb, 0x00,
+	0x27, 0x09, 0x92, 0xba, 0x41, 0x44, 0xc3, 0x6c, 0x96, 0x83, 0x6c, 0x96, 0xc3, 0xa7, 0x84, 0xd9,
+	0x2c, 0xef, 0x52, 0x8b, 0xa1, 0x6e, 0x55, 0xd1, 0x34, 0x7e, 0xd7, 0x40, 0x4f, 0xb3, 0x82, 0x08,
+	0x3f, 0x80, 0x31, 0xb5, 0x82, 0x3d, 0xcc, 0xdd, 0x0b, 0x99, 0xb9, 0x53, 0xaf, 0xc2, 0xf4, 0x25,
+	0x6f, 0x21, 0x77, 0x12, 0xe8, 0xfb, 0x25, 0xfa, 0x95, 0x33, 0xd1, 0x87, 0x98, 0x12, 0xf0, 0xdf,
+	0xc2, 0x9a, 0x54, 0x4d, 0x26, 0x0a, 0x62, 0x05, 0x26, 0x54, 0xd3, 0x35, 0xdb, 0xc4, 0x40, 0x8f,
+	0xab, 0xdb, 0x3b, 0xa6, 0xf1, 0x9f, 0x06, 0xa5, 0x6e, 0x57, 0x61, 0x30, 0xee, 0xc1, 0xa8, 0xaa,
+	0x84, 0x51, 0xef, 0x39, 0x16, 0x89, 0x4b, 0x48, 0x09, 0x20, 0x2e, 0x4c, 0xaf, 0xd0, 0xbf, 0x3c,
+	0xb0, 0x3a, 0x52, 0x55, 0x76, 0xd2, 0xaa, 0x70, 0x20, 0xad, 0x0a, 0xc9, 0x3a, 0xcc, 0x0a, 0x2e,
+	0x68, 0xa3, 0xd6, 0x29, 0x7e, 0x49, 0x8a, 0x4f, 0xcb, 0xc3, 0x64, 0xa9, 0x1b, 0x3f, 0x6a, 0x30,
+	0x23, 0x9d, 0xde, 0x0a, 0xda, 0xce, 0x3b, 0xdc, 0x8a, 0xc2, 0x36, 0x07, 0x83, 0xb4, 0x1e, 0x3b,
+	0x39, 0x52, 0xc5, 0x55, 0xfa, 0xfb, 0xea, 0x4f, 0x7f, 0x5f, 0x1d, 0x35, 0x3a, 0x70, 0xee, 0x1a,
+	0x7d, 0xac, 0xc1, 0x6c, 0x07, 0x4a, 0xcc, 0xc8, 0xdb, 0x30, 0xc4, 0x1c, 0xe1, 0xda, 0x2c, 0x2a,
+	0xcc, 0xab, 0x99, 0xc9, 0x88, 0xf4, 0xdf, 0x74, 0x84, 0x7b, 0x18, 0x35, 0x16, 0xbc, 0xe0, 0xe2,
+	0x6a, 0x72, 0x06, 0x48, 0xf8, 0xe4, 0xe5, 0x44, 0x89, 0x1a, 0xc1, 0x87, 0x30, 0x9d, 0xd8, 0x45,
+	0x0f, 0xb6, 0x60, 0x30, 0x9c, 0x3c, 0x58, 0x4d, 0x57, 0x32, 0x1d, 0x08, 0x95, 0x11, 0x39, 0x2a,
+	0x1a, 0xfb, 0xb0, 0x10, 0x46, 0xa7, 0x5e, 0xe7, 0xbe, 0x23, 0xb6, 0x1a, 0x0d, 0xfe, 0xb0, 0x61,
+	0x7b, 0xe2, 0xa2, 0x5b, 0xc5, 0x23, 0x0d, 0x16, 0xbb, 0x18, 0x42, 0x67, 0x74, 0x18, 0xa6, 0xe1,
+	0x59, 0x98, 0x8f, 0x91, 0x6a, 0xbc, 0x0e, 0xce, 0x5a, 0x2e, 0xdb, 0xb7, 0x3f, 0x61, 0x51, 0x95,
+	0xc7, 0x6b, 0x72, 0x27, 0xa5, 0x50, 0xce, 0x15, 0xfa, 0x45, 0xec, 0xe8, 0x77, 0x4f, 0x86, 0xe3,
+	0x5d, 0x6e, 0x46, 0xde, 0x18, 0xdf, 0x69, 0xb0, 0x90, 0x7e, 0x8e, 0x0e, 0x7c, 0x0c, 0x93, 0xca,
+	0x5c, 0xad, 0x35, 0xb9, 0xc9, 0x30, 0x60, 0x2f, 0x66, 0xe6, 0xa5, 0xe3, 0x3e, 0x4c, 0xd0, 0x44,
+	0x33, 0xb9, 0x1d, 0xbd, 0xaa, 0x36, 0x93, 0xe5, 0x35, 0x5c, 0xc5, 0x55, 0x5c, 0x31, 0xf7, 0x04,
+	0x15, 0x7e, 0x5c, 0x31, 0x3b, 0x30, 0x9d, 0xd8, 0x45, 0x8c, 0x73, 0x41, 0xc5, 0xf8, 0x1e, 0x0b,
+	0x1b, 0xd9, 0x70, 0x15, 0x57, 0x41, 0x80, 0x2d, 0x9f, 0xba, 0xa6, 0x4d, 0x1d, 0x7c, 0x91, 0xf1,
+	0xda, 0x78, 0x19, 0x27, 0xd4, 0x36, 0x63, 0x55, 0x56, 0xb7, 0x5b, 0x36, 0x73, 0xe2, 0xf2, 0x28,
+	0xc0, 0x50, 0x72, 0x50, 0x46, 0x4b, 0xe3, 0x00, 0x8a, 0x29, 0x5a, 0x08, 0xe3, 0x7d, 0x18, 0x0b,
+	0x38, 0x85, 0x1b, 0x1d, 0xe4, 0xea, 0x86, 0xea, 0x4d, 0x51, 0x37, 0xdc, 0x57, 0xf6, 0xe2, 0x99,
+	0xa7, 0x0a, 0x5e, 0xf8, 0xcc, 0xfb, 0x23, 0x9a, 0x79, 0x1d, 0x56, 0xd0, 0xb3, 0xfb, 0x30, 0x9e,
+	0xf0, 0x2c, 0xdf, 0xd0, 0x4b, 0x71, 0x6d, 0x4c, 0x75, 0xed, 0xe2, 0x1a, 0xcc, 0xfa, 0xd7, 0x13,
+	0xf0, 0x8c, 0xc4, 0x4f, 0x7e, 0xd5, 0x60, 0xea, 0x14, 0xe5, 0x22, 0xb7, 0x32, 0x81, 0x66, 0x72,
+	0x38, 0x7d, 0x23, 0xbb, 0xff, 0x74, 0xa3, 0x77, 0x86, 0xf1, 0xc5, 0x9f, 0xff, 0x7e, 0xdb, 0xbf,
+	0x40, 0xf4, 0x98, 0x4f, 0x87, 0xa2, 0x35, 0x65, 0x94, 0xfd, 0xa4, 0xc1, 0x78, 0x72, 0x00, 0x91,
+	0x9b, 0x67, 0x43, 0x4d, 0x25, 0x7a, 0xfa, 0xab, 0xbd, 0x2b, 0x22, 0xd2, 0x25, 0x89, 0xb4, 0x48,
+	0x2e, 0x47, 0x48, 0x3b, 0xe6, 0x25, 0xf9, 0x5e, 0x83, 0x51, 0x95, 0xae, 0x91, 0x57, 0x72, 0xc4,
+	0xf3, 0x34, 0xf5, 0xd3, 0x37, 0x7a, 0x55, 0x43, 0x80, 0x45, 0x09, 0x70, 0x9a, 0x4c, 0x9d, 0x7c,
+	0x13, 0x44, 0x48, 0x7e, 0xd0, 0x60, 0x2c, 0x41, 0xd4, 0x48, 0x0e, 0x23, 0x69, 0xfc, 0x51, 0xbf,
+	0xd9, 0xb3, 0x1e, 0xa2, 0x5b, 0x94, 0xe8, 0x2e, 0x93, 0xd9, 0xb4, 0x2f, 0x1c, 0x8f, 0xfc, 0xa6,
+	0xc1, 0xd4, 0x29, 0x06, 0x95, 0xa7, 0x22, 0xbb, 0x31, 0x38, 0xfd, 0xb5, 0x73, 0xe9, 0x76, 0x2b,
+	0xcb, 0x04, 0x19, 0x0c, 0xf3, 0xfd, 0x95, 0x06, 0xc3, 0x11, 0x33, 0x20, 0x6b, 0x67, 0x5b, 0xeb,
+	0xe0, 0x4a, 0xfa, 0x7a, 0x2f, 0x2a, 0xdd, 0x73, 0x6c, 0xda, 0xa2, 0xd6, 0xe0, 0x16, 0xf9, 0x52,
+	0x83, 0xc1, 0x70, 0xce, 0x93, 0x4a, 0x8e, 0x0a, 0x52, 0x49, 0x86, 0x7e, 0x23, 0xbf, 0x02, 0x02,
+	0x99, 0x93, 0x40, 0x26, 0xc9, 0x78, 0xf2, 0x3b, 0x98, 0x3c, 0xd6, 0x60, 0xb2, 0x73, 0xce, 0x93,
+	0xcd, 0x1c, 0x9e, 0xa6, 0x93, 0x10, 0xfd, 0xd6, 0x79, 0x54, 0x11, 0xe3, 0x73, 0x12, 0xe3, 0x3c,
+	0x29, 0xc6, 0xc1, 0x0a, 0x25, 0x6b, 0x34, 0x46, 0xf6, 0x8b, 0x06, 0x13, 0x1d, 0x43, 0x98, 0xe4,
+	0x68, 0x11, 0xe9, 0x3c, 0x41, 0xdf, 0x3c, 0x87, 0x26, 0x62, 0x5d, 0x96, 0x58, 0x75, 0x52, 0x48,
+	0xf9, 0x4e, 0x97, 0x7c, 0x42, 0xe6, 0x37, 0x1c, 0xe9, 0x79, 0xf2, 0x9b, 0xa0, 0x04, 0xfa, 0x8d,
+	0xfc, 0x0a, 0xdd, 0xf2, 0xeb, 0x85, 0xa6, 0x7f, 0xd6, 0x60, 0x54, 0x1d, 0x59, 0x79, 0x9a, 0x5c,
+	0x0a, 0x7b, 0xd0, 0x37, 0x7a, 0x55, 0x43, 0x5c, 0xab, 0x12, 0x97, 0x41, 0x96, 0xd5, 0x3f, 0x28,
+	0x4e, 0x46, 0x6e, 0xe5, 0x33, 0x24, 0x21, 0x9f, 0x93, 0x47, 0x1a, 0x8c, 0x6d, 0x27, 0x06, 0x69,
+	0x8f, 0x36, 0x7b, 0xe9, 0x79, 0xa9, 0x8c, 0xc0, 0x28, 0x49, 0xb0, 0x05, 0x32, 0x97, 0x0e, 0xf6,
+	0xf6, 0xeb, 0x4f, 0x8e, 0x4a, 0xda, 0xd3, 0xa3, 0x92, 0xf6, 0xcf, 0x51, 0x49, 0xfb, 0xe6, 0xb8,
+	0xd4, 0xf7, 0xf4, 0xb8, 0xd4, 0xf7, 0xd7, 0x71, 0xa9, 0xef, 0xa3, 0x2b, 0x96, 0x2d, 0x1e, 0xf8,
+	0x7b, 0xe5, 0x3a, 0x6f, 0x56, 0x14, 0xe3, 0xd7, 0xd5, 0xbf, 0x67, 0xf6, 0x06, 0xe5, 0xff, 0x2b,
+	0x2f, 0xfd, 0x3f, 0x00, 0x53, 0x65, 0xb1, 0x86, 0x1a, 0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// PendingValidators returns currently pending validators of the module.
+	PendingValidators(ctx context.Context, in *QueryPendingValidatorsRequest, opts ...grpc.CallOption) (*PendingValidatorsResponse, error)
+	// ConsensusPower returns the current consensus power of a validator.
+	ConsensusPower(ctx context.Context, in *QueryConsensusPowerRequest, opts ...grpc.CallOption) (*QueryConsensusPowerResponse, error)
+	// POA Authority
+	PoaAuthority(ctx context.Context, in *QueryPoaAuthorityRequest, opts ...grpc.CallOption) (*QueryPoaAuthorityResponse, error)
+	// Organizations returns all registered organizations.
+	Organizations(ctx context.Context, in *QueryOrganizationsRequest, opts ...grpc.CallOption) (*QueryOrganizationsResponse, error)
+	// OrganizationPower returns the validators and combined power of an organization.
+	OrganizationPower(ctx context.Context, in *QueryOrganizationPowerRequest, opts ...grpc.CallOption) (*QueryOrganizationPowerResponse, error)
+	// AuditLog returns the recorded administrative actions.
+	AuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
+	// Params returns the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// AccountAllowlist returns the accounts and address prefixes of the account
+	// allowlist.
+	AccountAllowlist(ctx context.Context, in *QueryAccountAllowlistRequest, opts ...grpc.CallOption) (*QueryAccountAllowlistResponse, error)
+	// MaintenanceMode returns the maintenance mode and whether it is active.
+	MaintenanceMode(ctx context.Context, in *QueryMaintenanceModeRequest, opts ...grpc.CallOption) (*QueryMaintenanceModeResponse, error)
+	// Status returns whether the validator set operations are paused.
+	Status(ctx context.Context, in *QueryStatusRequest, opts ...grpc.CallOption) (*QueryStatusResponse, error)
+	// FeeRecipient returns the accrued and paid fees of an account.
+	FeeRecipient(ctx context.Context, in *QueryFeeRecipientRequest, opts ...grpc.CallOption) (*QueryFeeRecipientResponse, error)
+	// FeeRecipients returns the accrued and paid fees of all the accounts.
+	FeeRecipients(ctx context.Context, in *QueryFeeRecipientsRequest, opts ...grpc.CallOption) (*QueryFeeRecipientsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) PendingValidators(ctx context.Context, in *QueryPendingValidatorsRequest, opts ...grpc.CallOption) (*PendingValidatorsResponse, error) {
+	out := new(PendingValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/strangelove_ventures.poa.v1.Query/PendingValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConsensusPower(ctx context.Context, in *QueryConsensusPowerRequest, opts ...grpc.CallOption) (*QueryConsensusPowerResponse, error) {
+	out := new(QueryConsensusPowerResponse)
+	err := c.cc.Invoke(ctx, "/strangelove_ventures.poa.v1.Query/ConsensusPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoaAuthority(ctx context.Context, in *QueryPoaAuthorityRequest, opts ...grpc.CallOption) (*QueryPoaAuthorityResponse, error) {
+	out := new(QueryPoaAuthorityResponse)
+	err := c.cc.Invoke(ctx, "/strangelove_ventures.poa.v1.Query/PoaAuthority", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Organizations(ctx context.Context, in *QueryOrganizationsRequest, opts ...grpc.CallOption) (*QueryOrganizationsResponse, error) {
+	out := new(QueryOrganizationsResponse)
+	err := c.cc.Invoke(ctx, "/strangelove_ventures.poa.v1.Query/Organizations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OrganizationPower(ctx context.Context, in *QueryOrganizationPowerRequest, opts ...grpc.CallOption) (*QueryOrganizationPowerResponse, error) {
+	out := new(QueryOrganizationPowerResponse)
+	err := c.cc.Invoke(ctx, "/strangelove_ventures.poa.v1.Query/OrganizationPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/strangelove_ventures.poa.v1.Query/AuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/strangelove_ventures.poa.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountAllowlist(ctx context.Context, in *QueryAccountAllowlistRequest, opts ...grpc.CallOption) (*QueryAccountAllowlistResponse, error) {
+	out := new(QueryAccountAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/strangelove_ventures.poa.v1.Query/AccountAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MaintenanceMode(ctx context.Context, in *QueryMaintenanceModeRequest, opts ...grpc.CallOption) (*QueryMaintenanceModeResponse, error) {
+	out := new(QueryMaintenanceModeResponse)
+	err := c.cc.Invoke(ctx, "/strangelove_ventures.poa.v1.Query/MaintenanceMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Status(ctx context.Context, in *QueryStatusRequest, opts ...grpc.CallOption) (*QueryStatusResponse, error) {
+	out := new(QueryStatusResponse)
+	err := c.cc.Invoke(ctx, "/strangelove_ventures.poa.v1.Query/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeRecipient(ctx context.Context, in *QueryFeeRecipientRequest, opts ...grpc.CallOption) (*QueryFeeRecipientResponse, error) {
+	out := new(QueryFeeRecipientResponse)
+	err := c.cc.Invoke(ctx, "/strangelove_ventures.poa.v1.Query/FeeRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeRecipients(ctx context.Context, in *QueryFeeRecipientsRequest, opts ...grpc.CallOption) (*QueryFeeRecipientsResponse, error) {
+	out := new(QueryFeeRecipientsResponse)
+	err := c.cc.Invoke(ctx, "/strangelove_ventures.poa.v1.Query/FeeRecipients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// PendingValidators returns currently pending validators of the module.
+	PendingValidators(context.Context, *QueryPendingValidatorsRequest) (*PendingValidatorsResponse, error)
+	// ConsensusPower returns the current consensus power of a validator.
+	ConsensusPower(context.Context, *QueryConsensusPowerRequest) (*QueryConsensusPowerResponse, error)
+	// POA Authority
+	PoaAuthority(context.Context, *QueryPoaAuthorityRequest) (*QueryPoaAuthorityResponse, error)
+	// Organizations returns all registered organizations.
+	Organizations(context.Context, *QueryOrganizationsRequest) (*QueryOrganizationsResponse, error)
+	// OrganizationPower returns the validators and combined power of an organization.
+	OrganizationPower(context.Context, *QueryOrganizationPowerRequest) (*QueryOrganizationPowerResponse, error)
+	// AuditLog returns the recorded administrative actions.
+	AuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
+	// Params returns the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// AccountAllowlist returns the accounts and address prefixes of the account
+	// allowlist.
+	AccountAllowlist(context.Context, *QueryAccountAllowlistRequest) (*QueryAccountAllowlistResponse, error)
+	// MaintenanceMode returns the maintenance mode and whether it is active.
+	MaintenanceMode(context.Context, *QueryMaintenanceModeRequest) (*QueryMaintenanceModeResponse, error)
+	// Status returns whether the validator set operations are paused.
+	Status(context.Context, *QueryStatusRequest) (*QueryStatusResponse, error)
+	// FeeRecipient returns the accrued and paid fees of an account.
+	FeeRecipient(context.Context, *QueryFeeRecipientRequest) (*QueryFeeRecipientResponse, error)
+	// FeeRecipients returns the accrued and paid fees of all the accounts.
+	FeeRecipients(context.Context, *QueryFeeRecipientsRequest) (*QueryFeeRecipientsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) PendingValidators(ctx context.Context, req *QueryPendingValidatorsRequest) (*PendingValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingValidators not implemented")
+}
+func (*UnimplementedQueryServer) ConsensusPower(ctx context.Context, req *QueryConsensusPowerRequest) (*QueryConsensusPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsensusPower not implemented")
+}
+func (*UnimplementedQueryServer) PoaAuthority(ctx context.Context, req *QueryPoaAuthorityRequest) (*QueryPoaAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoaAuthority not implemented")
+}
+func (*UnimplementedQueryServer) Organizations(ctx context.Context, req *QueryOrganizationsRequest) (*QueryOrganizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Organizations not implemented")
+}
+func (*UnimplementedQueryServer) OrganizationPower(ctx context.Context, req *QueryOrganizationPowerRequest) (*QueryOrganizationPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrganizationPower not implemented")
+}
+func (*UnimplementedQueryServer) AuditLog(ctx context.Context, req *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditLog not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) AccountAllowlist(ctx context.Context, req *QueryAccountAllowlistRequest) (*QueryAccountAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountAllowlist not implemented")
+}
+func (*UnimplementedQueryServer) MaintenanceMode(ctx context.Context, req *QueryMaintenanceModeRequest) (*QueryMaintenanceModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaintenanceMode not implemented")
+}
+func (*UnimplementedQueryServer) Status(ctx context.Context, req *QueryStatusRequest) (*QueryStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (*UnimplementedQueryServer) FeeRecipient(ctx context.Context, req *QueryFeeRecipientRequest) (*QueryFeeRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeRecipient not implemented")
+}
+func (*UnimplementedQueryServer) FeeRecipients(ctx context.Context, req *QueryFeeRecipientsRequest) (*QueryFeeRecipientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeRecipients not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_PendingValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove_ventures.poa.v1.Query/PendingValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingValidators(ctx, req.(*QueryPendingValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConsensusPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsensusPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsensusPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove_ventures.poa.v1.Query/ConsensusPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsensusPower(ctx, req.(*QueryConsensusPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoaAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoaAuthorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoaAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove_ventures.poa.v1.Query/PoaAuthority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoaAuthority(ctx, req.(*QueryPoaAuthorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Organizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrganizationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Organizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove_ventures.poa.v1.Query/Organizations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Organizations(ctx, req.(*QueryOrganizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OrganizationPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrganizationPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrganizationPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove_ventures.poa.v1.Query/OrganizationPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrganizationPower(ctx, req.(*QueryOrganizationPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove_ventures.poa.v1.Query/AuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove_ventures.poa.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove_ventures.poa.v1.Query/AccountAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountAllowlist(ctx, req.(*QueryAccountAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaintenanceModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaintenanceMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove_ventures.poa.v1.Query/MaintenanceMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaintenanceMode(ctx, req.(*QueryMaintenanceModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove_ventures.poa.v1.Query/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Status(ctx, req.(*QueryStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove_ventures.poa.v1.Query/FeeRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeRecipient(ctx, req.(*QueryFeeRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeRecipients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeRecipientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeRecipients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strangelove_ventures.poa.v1.Query/FeeRecipients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeRecipients(ctx, req.(*QueryFeeRecipientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "strangelove_ventures.poa.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PendingValidators",
+			Handler:    _Query_PendingValidators_Handler,
+		},
+		{
+			MethodName: "ConsensusPower",
+			Handler:    _Query_ConsensusPower_Handler,
+		},
+		{
+			MethodName: "PoaAuthority",
+			Handler:    _Query_PoaAuthority_Handler,
+		},
+		{
+			MethodName: "Organizations",
+			Handler:    _Query_Organizations_Handler,
+		},
+		{
+			MethodName: "OrganizationPower",
+			Handler:    _Query_OrganizationPower_Handler,
+		},
+		{
+			MethodName: "AuditLog",
+			Handler:    _Query_AuditLog_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AccountAllowlist",
+			Handler:    _Query_AccountAllowlist_Handler,
+		},
+		{
+			MethodName: "MaintenanceMode",
+			Handler:    _Query_MaintenanceMode_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _Query_Status_Handler,
+		},
+		{
+			MethodName: "FeeRecipient",
+			Handler:    _Query_FeeRecipient_Handler,
+		},
+		{
+			MethodName: "FeeRecipients",
+			Handler:    _Query_FeeRecipients_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "strangelove_ventures/poa/v1/query.proto",
+}
+
+func (m *QueryPendingValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsensusPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsensusPower))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoaAuthorityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoaAuthorityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoaAuthorityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoaAuthorityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoaAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoaAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrganizationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrganizationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrganizationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrganizationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrganizationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrganizationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Organizations) > 0 {
+		for iNdEx := len(m.Organizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Organizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrganizationPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrganizationPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrganizationPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrganizationId) > 0 {
+		i -= len(m.OrganizationId)
+		copy(dAtA[i:], m.OrganizationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrganizationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrganizationPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrganizationPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrganizationPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalConsensusPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalConsensusPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ConsensusPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsensusPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Organization.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuditLogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuditLogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuditLogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuditLogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuditLogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuditLogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Prefixes) > 0 {
+		for iNdEx := len(m.Prefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Prefixes[iNdEx])
+			copy(dAtA[i:], m.Prefixes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Prefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMaintenanceModeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMaintenanceModeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMaintenanceModeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMaintenanceModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMaintenanceModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMaintenanceModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.MaintenanceMode.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeRecipientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeRecipientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeRecipientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeRecipientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeRecipient.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeRecipientsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeRecipientsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeRecipientsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeRecipientsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeRecipientsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeRecipientsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeeRecipients) > 0 {
+		for iNdEx := len(m.FeeRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPendingValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PendingValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsensusPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsensusPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsensusPower != 0 {
+		n += 1 + sovQuery(uint64(m.ConsensusPower))
+	}
+	return n
+}
+
+func (m *QueryPoaAuthorityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPoaAuthorityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrganizationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrganizationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Organizations) > 0 {
+		for _, e := range m.Organizations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrganizationPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrganizationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrganizationPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Organization.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ConsensusPower != 0 {
+		n += 1 + sovQuery(uint64(m.ConsensusPower))
+	}
+	if m.TotalConsensusPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalConsensusPower))
+	}
+	return n
+}
+
+func (m *QueryAuditLogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuditLogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Prefixes) > 0 {
+		for _, s := range m.Prefixes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMaintenanceModeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMaintenanceModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaintenanceMode.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Active {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeRecipientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeRecipientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeRecipient.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeRecipientsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeRecipientsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	var l int
+	_ = l
+	if len(m.FeeRecipients) > 0 {
+		for _, e := range m.FeeRecipients {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPendingValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, Validator{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPower", wireType)
+			}
+			m.ConsensusPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsensusPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoaAuthorityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoaAuthorityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoaAuthorityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoaAuthorityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoaAuthorityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoaAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrganizationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrganizationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrganizationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrganizationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrganizationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrganizationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Organizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Organizations = append(m.Organizations, Organization{})
+			if err := m.Organizations[len(m.Organizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrganizationPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrganizationPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrganizationPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrganizationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrganizationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrganizationPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrganizationPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrganizationPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Organization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPower", wireType)
+			}
+			m.ConsensusPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsensusPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalConsensusPower", wireType)
+			}
+			m.TotalConsensusPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalConsensusPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuditLogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuditLogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuditLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuditLogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuditLogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuditLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, AuditLogEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountAllowlistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountAllowlistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefixes = append(m.Prefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMaintenanceModeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMaintenanceModeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMaintenanceModeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryMaintenanceModeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMaintenanceModeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMaintenanceModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryPendingValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -774,10 +4701,10 @@ func (m *QueryPendingValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -801,7 +4728,7 @@ func (m *QueryPendingValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PendingValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -824,17 +4751,37 @@ func (m *PendingValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PendingValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -844,25 +4791,23 @@ func (m *PendingValidatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pending = append(m.Pending, Validator{})
-			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -885,7 +4830,7 @@ func (m *PendingValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsensusPowerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeRecipientRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -908,15 +4853,15 @@ func (m *QueryConsensusPowerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsensusPowerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeRecipientRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsensusPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeRecipientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -944,7 +4889,7 @@ func (m *QueryConsensusPowerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -967,7 +4912,7 @@ func (m *QueryConsensusPowerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsensusPowerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeRecipientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -990,17 +4935,17 @@ func (m *QueryConsensusPowerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsensusPowerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeRecipientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsensusPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPower", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
 			}
-			m.ConsensusPower = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1010,11 +4955,25 @@ func (m *QueryConsensusPowerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConsensusPower |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRecipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1036,7 +4995,7 @@ func (m *QueryConsensusPowerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoaAuthorityRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeRecipientsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1059,12 +5018,48 @@ func (m *QueryPoaAuthorityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoaAuthorityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeRecipientsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoaAuthorityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeRecipientsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1086,7 +5081,7 @@ func (m *QueryPoaAuthorityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoaAuthorityResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeRecipientsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1109,17 +5104,17 @@ func (m *QueryPoaAuthorityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoaAuthorityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeRecipientsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoaAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeRecipientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipients", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1129,23 +5124,61 @@ func (m *QueryPoaAuthorityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.FeeRecipients = append(m.FeeRecipients, FeeRecipient{})
+			if err := m.FeeRecipients[len(m.FeeRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
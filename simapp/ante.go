@@ -3,7 +3,6 @@ package simapp
 import (
 	"errors"
 
-	"cosmossdk.io/math"
 	circuitante "cosmossdk.io/x/circuit/ante"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,6 +15,16 @@ import (
 type HandlerOptions struct {
 	ante.HandlerOptions
 	CircuitKeeper circuitante.CircuitBreaker
+	POAKeeper     POAKeeper
+}
+
+// POAKeeper is the POA keeper used by the POA ante decorators.
+type POAKeeper interface {
+	poaante.MsgFilterKeeper
+	poaante.CommissionLimitKeeper
+	poaante.AdminTxKeeper
+	poaante.AccountAllowlistKeeper
+	poaante.MaintenanceKeeper
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -34,11 +43,12 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errors.New("sign mode handler is required for ante builder")
 	}
 
-	doGenTxRateValidation := false
+	if options.POAKeeper == nil {
+		return nil, errors.New("poa keeper is required for ante builder")
+	}
 
-	// example floor and ceiling for commission rate (poa.NewMsgCommissionLimiterDecorator)
-	rateFloor := math.LegacyMustNewDecFromStr("0.10")
-	rateCeil := math.LegacyMustNewDecFromStr("0.50")
+	// example gas limit of the fee exempted POA admin transactions.
+	const adminTxMaxGas = 500_000
 
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
@@ -48,15 +58,18 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		// the POA maintenance, account allowlist and admin fee decorators must be called before DeductFeeDecorator
+		poaante.NewPOAMaintenanceDecorator(options.POAKeeper),
+		poaante.NewPOAAccountAllowlistDecorator(options.POAKeeper),
+		poaante.NewPOAAdminFeeDecorator(options.POAKeeper, adminTxMaxGas),
 		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
 		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
-		poaante.NewPOADisableStakingDecorator(),
-		poaante.NewPOADisableWithdrawDelegatorRewards(),
-		poaante.NewCommissionLimitDecorator(doGenTxRateValidation, rateFloor, rateCeil),
+		poaante.NewPOAMsgFilterDecorator(options.POAKeeper),
+		poaante.NewPOACommissionLimitDecorator(options.POAKeeper),
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
@@ -127,6 +127,7 @@ var (
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		nft.ModuleName:                 nil,
+		poa.ModuleName:                 {authtypes.Minter, authtypes.Burner},
 	}
 
 	govModAddress = authtypes.NewModuleAddress(govtypes.ModuleName).String()
@@ -333,6 +334,7 @@ func NewSimApp(
 		stakingtypes.NewMultiStakingHooks(
 			app.DistrKeeper.Hooks(),
 			app.SlashingKeeper.Hooks(),
+			app.POAKeeper.Hooks(),
 		),
 	)
 
@@ -580,6 +582,7 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
 			&app.CircuitKeeper,
+			app.POAKeeper,
 		},
 	)
 	if err != nil {
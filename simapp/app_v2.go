@@ -194,6 +194,7 @@ func (app *SimApp) setAnteHandler() {
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
 			&app.CircuitKeeper,
+			app.POAKeeper,
 		},
 	)
 	if err != nil {
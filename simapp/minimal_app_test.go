@@ -0,0 +1,218 @@
+package simapp
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
+	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
+	authmodulev1 "cosmossdk.io/api/cosmos/auth/module/v1"
+	bankmodulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
+	consensusmodulev1 "cosmossdk.io/api/cosmos/consensus/module/v1"
+	genutilmodulev1 "cosmossdk.io/api/cosmos/genutil/module/v1"
+	stakingmodulev1 "cosmossdk.io/api/cosmos/staking/module/v1"
+	txconfigv1 "cosmossdk.io/api/cosmos/tx/config/v1"
+	"cosmossdk.io/core/appconfig"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/strangelove-ventures/poa"
+	poamodulev1 "github.com/strangelove-ventures/poa/api/module/v1"
+	poakeeper "github.com/strangelove-ventures/poa/keeper"
+)
+
+// minimalAppConfig is the app config of a fixed supply POA chain, with only the modules POA depends on and without
+// x/mint, x/slashing or x/distribution.
+func minimalAppConfig() depinject.Config {
+	return appconfig.Compose(&appv1alpha1.Config{
+		Modules: []*appv1alpha1.ModuleConfig{
+			{
+				Name: runtime.ModuleName,
+				Config: appconfig.WrapAny(&runtimev1alpha1.Module{
+					AppName: "MinimalSimApp",
+					BeginBlockers: []string{
+						poa.ModuleName,
+						stakingtypes.ModuleName,
+						genutiltypes.ModuleName,
+					},
+					EndBlockers: []string{
+						poa.ModuleName,
+						stakingtypes.ModuleName,
+						genutiltypes.ModuleName,
+					},
+					InitGenesis: []string{
+						authtypes.ModuleName,
+						banktypes.ModuleName,
+						stakingtypes.ModuleName,
+						genutiltypes.ModuleName,
+						consensustypes.ModuleName,
+						poa.ModuleName,
+					},
+				}),
+			},
+			{
+				Name: authtypes.ModuleName,
+				Config: appconfig.WrapAny(&authmodulev1.Module{
+					Bech32Prefix: "cosmos",
+					ModuleAccountPermissions: []*authmodulev1.ModuleAccountPermission{
+						{Account: authtypes.FeeCollectorName},
+						{Account: stakingtypes.BondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
+						{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
+						{Account: poa.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
+					},
+				}),
+			},
+			{
+				Name:   banktypes.ModuleName,
+				Config: appconfig.WrapAny(&bankmodulev1.Module{}),
+			},
+			{
+				Name:   stakingtypes.ModuleName,
+				Config: appconfig.WrapAny(&stakingmodulev1.Module{}),
+			},
+			{
+				Name:   "tx",
+				Config: appconfig.WrapAny(&txconfigv1.Config{}),
+			},
+			{
+				Name:   genutiltypes.ModuleName,
+				Config: appconfig.WrapAny(&genutilmodulev1.Module{}),
+			},
+			{
+				Name:   consensustypes.ModuleName,
+				Config: appconfig.WrapAny(&consensusmodulev1.Module{}),
+			},
+			{
+				Name:   poa.ModuleName,
+				Config: appconfig.WrapAny(&poamodulev1.Module{}),
+			},
+		},
+	})
+}
+
+func TestPOAWithoutMint(t *testing.T) {
+	var (
+		poaKeeper     poakeeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+		bankKeeper    bankkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(
+		depinject.Configs(minimalAppConfig(), depinject.Supply(log.NewNopLogger())),
+		&poaKeeper, &stakingKeeper, &bankKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false)
+	msgServer := poakeeper.NewMsgServerImpl(poaKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	vals, err := stakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, vals)
+
+	bondDenom, err := stakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+
+	bondedPool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName)
+
+	// the power is minted and burned by the POA module account.
+	for _, power := range []uint64{10_000_000, 2_000_000} {
+		supply := bankKeeper.GetSupply(ctx, bondDenom).Amount
+		pool := bankKeeper.GetBalance(ctx, bondedPool, bondDenom).Amount
+
+		_, err = msgServer.SetPower(ctx, &poa.MsgSetPower{
+			Sender:           authority,
+			ValidatorAddress: vals[0].OperatorAddress,
+			Power:            power,
+			Unsafe:           true,
+		})
+		require.NoError(t, err)
+
+		bonded, err := poaKeeper.GetBondedTokens(ctx)
+		require.NoError(t, err)
+
+		newPool := bankKeeper.GetBalance(ctx, bondedPool, bondDenom).Amount
+		require.Equal(t, bonded.String(), newPool.String())
+		require.Equal(t, supply.Add(newPool.Sub(pool)).String(), bankKeeper.GetSupply(ctx, bondDenom).Amount.String())
+	}
+
+	require.True(t, bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(poa.ModuleName), bondDenom).Amount.Equal(sdkmath.ZeroInt()))
+}
+
+func TestPOAWithoutSlashing(t *testing.T) {
+	var (
+		poaKeeper     poakeeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(
+		depinject.Configs(minimalAppConfig(), depinject.Supply(log.NewNopLogger())),
+		&poaKeeper, &stakingKeeper,
+	)
+	require.NoError(t, err)
+	require.Nil(t, poaKeeper.GetSlashingKeeper())
+
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight() + 1, Time: time.Now().UTC()})
+	msgServer := poakeeper.NewMsgServerImpl(poaKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	bonded, err := poaKeeper.GetBondedTokens(ctx)
+	require.NoError(t, err)
+
+	// a validator is created, accepted into the set and removed again.
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	msg, err := poa.NewMsgCreateValidator(
+		valAddr.String(), ed25519.GenPrivKey().PubKey(),
+		poa.NewDescription("val", "", "", "", ""),
+		poa.NewCommissionRates(sdkmath.LegacyNewDecWithPrec(1, 1), sdkmath.LegacyNewDecWithPrec(2, 1), sdkmath.LegacyNewDecWithPrec(1, 2)),
+		sdkmath.OneInt(),
+	)
+	require.NoError(t, err)
+
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(t, err)
+
+	_, err = msgServer.SetPower(ctx, &poa.MsgSetPower{Sender: authority, ValidatorAddress: valAddr.String(), Power: 1_000_000, Unsafe: true})
+	require.NoError(t, err)
+
+	ctx, err = simtestutil.NextBlock(app, ctx, time.Second)
+	require.NoError(t, err)
+
+	val, err := stakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.True(t, val.IsBonded())
+
+	_, err = msgServer.RemoveValidator(ctx, &poa.MsgRemoveValidator{Sender: authority, ValidatorAddress: valAddr.String()})
+	require.NoError(t, err)
+
+	ctx, err = simtestutil.NextBlock(app, ctx, time.Second)
+	require.NoError(t, err)
+
+	val, err = stakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.False(t, val.IsBonded())
+
+	remaining, err := poaKeeper.GetBondedTokens(ctx)
+	require.NoError(t, err)
+	require.Equal(t, bonded.String(), remaining.String())
+
+	// the slashing params can not be updated without x/slashing.
+	_, err = msgServer.UpdateSlashingParams(ctx, &poa.MsgUpdateSlashingParams{Sender: authority, Params: poa.DefaultSlashingParams()})
+	require.ErrorContains(t, err, "requires x/slashing")
+}
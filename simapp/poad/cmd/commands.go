@@ -12,6 +12,7 @@ import (
 
 	"cosmossdk.io/log"
 	confixcmd "cosmossdk.io/tools/confix/cmd"
+	poacli "github.com/strangelove-ventures/poa/client/cli"
 	"github.com/strangelove-ventures/poa/simapp"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -121,7 +122,7 @@ func initRootCmd(
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
 		server.StatusCommand(),
-		genesisCommand(txConfig, basicManager),
+		genesisCommand(txConfig, basicManager, poacli.AddGenesisValidatorCmd(simapp.DefaultNodeHome)),
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
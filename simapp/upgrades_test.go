@@ -0,0 +1,518 @@
+package simapp
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/strangelove-ventures/poa"
+	poakeeper "github.com/strangelove-ventures/poa/keeper"
+	"github.com/strangelove-ventures/poa/migrations"
+)
+
+// upgradeTestChain runs a simapp in process, block by block.
+type upgradeTestChain struct {
+	t         *testing.T
+	app       *SimApp
+	blockTime time.Time
+}
+
+func newUpgradeTestChain(t *testing.T) *upgradeTestChain {
+	t.Helper()
+
+	app := Setup(t, false)
+	_, err := app.Commit()
+	require.NoError(t, err)
+
+	return &upgradeTestChain{t: t, app: app, blockTime: time.Now().UTC()}
+}
+
+// ctx returns a context writing directly to the committed state of the last block.
+func (c *upgradeTestChain) ctx() sdk.Context {
+	return c.app.BaseApp.NewUncachedContext(false, cmtproto.Header{Height: c.app.LastBlockHeight(), Time: c.blockTime})
+}
+
+func (c *upgradeTestChain) nextBlock() *abci.ResponseFinalizeBlock {
+	c.t.Helper()
+
+	c.blockTime = c.blockTime.Add(5 * time.Second)
+	res, err := c.app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: c.app.LastBlockHeight() + 1, Time: c.blockTime})
+	require.NoError(c.t, err)
+
+	_, err = c.app.Commit()
+	require.NoError(c.t, err)
+
+	return res
+}
+
+// upgrade runs the upgrade handler in the next block.
+func (c *upgradeTestChain) upgrade(name string, handler upgradetypes.UpgradeHandler) *abci.ResponseFinalizeBlock {
+	c.t.Helper()
+
+	c.app.UpgradeKeeper.SetUpgradeHandler(name, handler)
+
+	height := c.app.LastBlockHeight() + 1
+	require.NoError(c.t, c.app.UpgradeKeeper.ScheduleUpgrade(c.ctx(), upgradetypes.Plan{Name: name, Height: height}))
+
+	res := c.nextBlock()
+
+	done, err := c.app.UpgradeKeeper.GetDoneHeight(c.ctx(), name)
+	require.NoError(c.t, err)
+	require.Equal(c.t, height, done)
+
+	return res
+}
+
+func TestPoSToPoAUpgrade(t *testing.T) {
+	require := require.New(t)
+
+	chain := newUpgradeTestChain(t)
+	app := chain.app
+
+	ctx := chain.ctx()
+	msgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	addrs := AddTestAddrsIncremental(app, ctx, 4, sdkmath.NewInt(100_000_000))
+
+	genesisVals, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(err)
+	require.Len(genesisVals, 1)
+	val1 := genesisVals[0].OperatorAddress
+
+	// a PoS network with third party delegations, unbondings and redelegations.
+	createValidator := func(operator sdk.AccAddress, amt int64) (string, sdk.ConsAddress) {
+		pk := ed25519.GenPrivKey().PubKey()
+		pkAny, err := codectypes.NewAnyWithValue(pk)
+		require.NoError(err)
+
+		valAddr := sdk.ValAddress(operator).String()
+		_, err = msgServer.CreateValidator(ctx, &stakingtypes.MsgCreateValidator{
+			Description:       stakingtypes.NewDescription("val", "", "", "", ""),
+			Commission:        stakingtypes.NewCommissionRates(sdkmath.LegacyNewDecWithPrec(1, 1), sdkmath.LegacyNewDecWithPrec(2, 1), sdkmath.LegacyNewDecWithPrec(1, 2)),
+			MinSelfDelegation: sdkmath.NewInt(amt),
+			ValidatorAddress:  valAddr,
+			Pubkey:            pkAny,
+			Value:             sdk.NewInt64Coin(sdk.DefaultBondDenom, amt),
+		})
+		require.NoError(err)
+
+		return valAddr, sdk.ConsAddress(pk.Address())
+	}
+
+	val2, _ := createValidator(addrs[0], 10_000_000)
+	val3, consAddr3 := createValidator(addrs[1], 5_000_000)
+
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(addrs[2].String(), val2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 20_000_000)))
+	require.NoError(err)
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(addrs[3].String(), val1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000)))
+	require.NoError(err)
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(addrs[3].String(), val3, sdk.NewInt64Coin(sdk.DefaultBondDenom, 3_000_000)))
+	require.NoError(err)
+
+	chain.nextBlock()
+	ctx = chain.ctx()
+
+	_, err = msgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(addrs[2].String(), val2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 5_000_000)))
+	require.NoError(err)
+	_, err = msgServer.BeginRedelegate(ctx, stakingtypes.NewMsgBeginRedelegate(addrs[3].String(), val1, val2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 4_000_000)))
+	require.NoError(err)
+
+	// val3 is jailed and starts unbonding at the end of the block.
+	require.NoError(app.StakingKeeper.Jail(ctx, consAddr3))
+
+	chain.nextBlock()
+	ctx = chain.ctx()
+
+	val3Addr, err := sdk.ValAddressFromBech32(val3)
+	require.NoError(err)
+	v3, err := app.StakingKeeper.GetValidator(ctx, val3Addr)
+	require.NoError(err)
+	require.True(v3.IsUnbonding())
+
+	// the tokens each delegator is owed: delegations and pending unbondings.
+	owed := make(map[string]sdkmath.Int)
+	addOwed := func(addr string, amt sdkmath.Int) {
+		if _, ok := owed[addr]; !ok {
+			owed[addr] = sdkmath.ZeroInt()
+		}
+		owed[addr] = owed[addr].Add(amt)
+	}
+
+	dels, err := app.StakingKeeper.GetAllDelegations(ctx)
+	require.NoError(err)
+	for _, del := range dels {
+		valAddr, err := sdk.ValAddressFromBech32(del.ValidatorAddress)
+		require.NoError(err)
+		val, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.NoError(err)
+		addOwed(del.DelegatorAddress, val.TokensFromShares(del.Shares).TruncateInt())
+	}
+
+	ubds, err := app.StakingKeeper.GetUnbondingDelegations(ctx, addrs[2], 10)
+	require.NoError(err)
+	require.Len(ubds, 1)
+	addOwed(addrs[2].String(), ubds[0].Entries[0].Balance)
+
+	balances := make(map[string]sdkmath.Int)
+	for addr := range owed {
+		balances[addr] = app.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(addr), sdk.DefaultBondDenom).Amount
+	}
+
+	// upgrade in process to PoA, with every validator given 2 power.
+	res := chain.upgrade("pos-to-poa", func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		versionMap, err := app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		keepers := migrations.Keepers{
+			BankKeeper:    app.BankKeeper,
+			StakingKeeper: app.StakingKeeper,
+			POAKeeper:     app.POAKeeper,
+		}
+
+		return versionMap, migrations.MigrateFromPoS(ctx, keepers, app.POAKeeper.GetAdmin(ctx), migrations.EqualPower(2_000_000))
+	})
+
+	ctx = chain.ctx()
+
+	// the upgrade block returns the new POA powers to consensus.
+	require.Len(res.ValidatorUpdates, 2)
+	for _, update := range res.ValidatorUpdates {
+		require.EqualValues(2, update.Power)
+	}
+
+	// every bonded validator has a single self delegation of its POA power.
+	for _, valOp := range []string{val1, val2} {
+		valAddr, err := sdk.ValAddressFromBech32(valOp)
+		require.NoError(err)
+
+		val, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.NoError(err)
+		require.True(val.IsBonded())
+		require.EqualValues(2_000_000, val.Tokens.Int64())
+
+		valDels, err := app.StakingKeeper.GetValidatorDelegations(ctx, valAddr)
+		require.NoError(err)
+		require.Len(valDels, 1)
+		require.Equal(sdk.AccAddress(valAddr).String(), valDels[0].DelegatorAddress)
+		require.Equal(val.DelegatorShares, valDels[0].Shares)
+
+		power, err := app.StakingKeeper.GetLastValidatorPower(ctx, valAddr)
+		require.NoError(err)
+		require.EqualValues(2, power)
+	}
+
+	// the unbonding validator is removed.
+	_, err = app.StakingKeeper.GetValidator(ctx, val3Addr)
+	require.ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+
+	// delegations, unbondings and redelegations are gone and refunded.
+	dels, err = app.StakingKeeper.GetAllDelegations(ctx)
+	require.NoError(err)
+	require.Len(dels, 2)
+
+	ubds, err = app.StakingKeeper.GetUnbondingDelegations(ctx, addrs[2], 10)
+	require.NoError(err)
+	require.Empty(ubds)
+
+	reds, err := app.StakingKeeper.GetRedelegations(ctx, addrs[3], 10)
+	require.NoError(err)
+	require.Empty(reds)
+
+	for addr, amt := range owed {
+		// delegation rewards are withdrawn on top of the refund.
+		refund := app.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(addr), sdk.DefaultBondDenom).Amount.Sub(balances[addr])
+		require.True(refund.GTE(amt), "%s refunded %s, expected at least %s", addr, refund, amt)
+	}
+
+	// the pools back exactly the POA power.
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+	require.EqualValues(4_000_000, app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), sdk.DefaultBondDenom).Amount.Int64())
+
+	msg, broken := stakingkeeper.AllInvariants(app.StakingKeeper)(ctx)
+	require.False(broken, msg)
+
+	// the chain keeps producing blocks, with the POA power caches following the new set.
+	chain.nextBlock()
+
+	cached, err := app.POAKeeper.GetCachedBlockPower(chain.ctx())
+	require.NoError(err)
+	require.EqualValues(4, cached)
+}
+
+func TestPoAToPoSUpgrade(t *testing.T) {
+	testCases := []struct {
+		name   string
+		funded bool
+	}{
+		{name: "minted stake is kept explicitly"},
+		{name: "stake funded by a funding source", funded: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+
+			chain := newUpgradeTestChain(t)
+			app := chain.app
+
+			ctx := chain.ctx()
+			poaMsgServer := poakeeper.NewMsgServerImpl(app.POAKeeper)
+			admin := app.POAKeeper.GetAdmin(ctx)
+			addrs := AddTestAddrsIncremental(app, ctx, 4, sdkmath.NewInt(100_000_000))
+
+			// a PoA network where validators were added, had their power changed and were removed.
+			createValidator := func(operator sdk.AccAddress, power uint64) string {
+				valAddr := sdk.ValAddress(operator).String()
+				msg, err := poa.NewMsgCreateValidator(
+					valAddr, ed25519.GenPrivKey().PubKey(),
+					poa.NewDescription("val", "", "", "", ""),
+					poa.NewCommissionRates(sdkmath.LegacyNewDecWithPrec(1, 1), sdkmath.LegacyNewDecWithPrec(2, 1), sdkmath.LegacyNewDecWithPrec(1, 2)),
+					sdkmath.OneInt(),
+				)
+				require.NoError(err)
+
+				_, err = poaMsgServer.CreateValidator(ctx, msg)
+				require.NoError(err)
+
+				_, err = poaMsgServer.SetPower(ctx, &poa.MsgSetPower{Sender: admin, ValidatorAddress: valAddr, Power: power, Unsafe: true})
+				require.NoError(err)
+
+				return valAddr
+			}
+
+			val2 := createValidator(addrs[0], 3_000_000)
+			val3 := createValidator(addrs[1], 2_000_000)
+
+			chain.nextBlock()
+			ctx = chain.ctx()
+
+			_, err := poaMsgServer.SetPower(ctx, &poa.MsgSetPower{Sender: admin, ValidatorAddress: val2, Power: 4_000_000, Unsafe: true})
+			require.NoError(err)
+
+			chain.nextBlock()
+			ctx = chain.ctx()
+
+			_, err = poaMsgServer.RemoveValidator(ctx, &poa.MsgRemoveValidator{Sender: admin, ValidatorAddress: val3})
+			require.NoError(err)
+
+			// the crisis module asserts the invariants every 5 blocks, which the POA state breaks until the upgrade.
+			chain.nextBlock()
+			ctx = chain.ctx()
+
+			// POA leaves power index entries which do not match the validator tokens.
+			powerIndex := func(ctx sdk.Context) map[string][]int64 {
+				iter, err := app.StakingKeeper.ValidatorsPowerStoreIterator(ctx)
+				require.NoError(err)
+				defer iter.Close()
+
+				index := make(map[string][]int64)
+				for ; iter.Valid(); iter.Next() {
+					valAddr := sdk.ValAddress(stakingtypes.ParseValidatorPowerRankKey(iter.Key())).String()
+					index[valAddr] = append(index[valAddr], int64(binary.BigEndian.Uint64(iter.Key()[1:9])))
+				}
+				return index
+			}
+			require.NotEqual([]int64{4}, powerIndex(ctx)[val2])
+
+			// the app removing POA has no POA module account permissions, x/mint mints a pool shortfall instead.
+			opts := migrations.PoSOptions{KeepMintedStake: true, Minter: minttypes.ModuleName}
+			if tc.funded {
+				opts = migrations.PoSOptions{FundingSource: addrs[3], Minter: minttypes.ModuleName}
+			}
+			fundingBefore := app.BankKeeper.GetBalance(ctx, addrs[3], sdk.DefaultBondDenom).Amount
+
+			// without a funding source, keeping the minted stake must be chosen explicitly.
+			cacheCtx, _ := ctx.CacheContext()
+			require.ErrorContains(migrations.MigrateToPoS(cacheCtx, app.BankKeeper, app.StakingKeeper, migrations.PoSOptions{}), "funding source is required")
+
+			poolsBalance := func(ctx sdk.Context) sdkmath.Int {
+				bonded := app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetBondedPool(ctx).GetAddress(), sdk.DefaultBondDenom).Amount
+				notBonded := app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetNotBondedPool(ctx).GetAddress(), sdk.DefaultBondDenom).Amount
+				return bonded.Add(notBonded)
+			}
+
+			// the supply is measured around the migration, x/mint inflation runs in the upgrade block.
+			var supplyBefore, supplyAfter, poolsBefore, poolsAfter sdkmath.Int
+
+			chain.upgrade("poa-to-pos", func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+				sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+				// the POA module account has no Minter permission once POA is removed.
+				poaAcc, ok := app.AccountKeeper.GetModuleAccount(ctx, poa.ModuleName).(*authtypes.ModuleAccount)
+				require.True(ok)
+				app.AccountKeeper.SetModuleAccount(ctx, authtypes.NewModuleAccount(poaAcc.BaseAccount, poa.ModuleName))
+
+				// a pool shortfall is minted.
+				shortfall := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000))
+				if err := app.BankKeeper.SendCoinsFromModuleToAccount(ctx, stakingtypes.BondedPoolName, addrs[2], shortfall); err != nil {
+					return nil, err
+				}
+
+				supplyBefore, poolsBefore = app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount, poolsBalance(sdkCtx)
+
+				if err := migrations.MigrateToPoS(ctx, app.BankKeeper, app.StakingKeeper, opts); err != nil {
+					return nil, err
+				}
+
+				supplyAfter, poolsAfter = app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount, poolsBalance(sdkCtx)
+
+				return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+			})
+			ctx = chain.ctx()
+
+			msg, broken := stakingkeeper.AllInvariants(app.StakingKeeper)(ctx)
+			require.False(broken, msg)
+
+			// every validator is indexed once by its tokens, the removed validator has no power left.
+			index := powerIndex(ctx)
+			require.Equal([]int64{4}, index[val2])
+			require.Equal([]int64{0}, index[val3])
+
+			val3Addr, err := sdk.ValAddressFromBech32(val3)
+			require.NoError(err)
+			val3Dels, err := app.StakingKeeper.GetValidatorDelegations(ctx, val3Addr)
+			require.NoError(err)
+			require.Empty(val3Dels)
+
+			// the bonded pool backs exactly the bonded tokens.
+			bondedTokens := sdkmath.ZeroInt()
+			bondedVals, err := app.StakingKeeper.GetBondedValidatorsByPower(ctx)
+			require.NoError(err)
+			for _, val := range bondedVals {
+				bondedTokens = bondedTokens.Add(val.Tokens)
+			}
+			require.EqualValues(5_000_000, bondedTokens.Int64())
+
+			bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+			require.Equal(bondedTokens, app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), sdk.DefaultBondDenom).Amount)
+
+			fundingAfter := app.BankKeeper.GetBalance(ctx, addrs[3], sdk.DefaultBondDenom).Amount
+			if tc.funded {
+				// only the POA self delegation is funded, the genesis validator is delegated by a genesis account.
+				selfTokens := sdkmath.NewInt(4_000_000)
+				require.Equal(selfTokens, fundingBefore.Sub(fundingAfter))
+
+				// the minted tokens of the pools are burned, the self delegations are transferred instead of minted.
+				require.Equal(supplyBefore.Sub(poolsBefore).Add(poolsAfter.Sub(selfTokens)).String(), supplyAfter.String())
+			} else {
+				require.Equal(fundingBefore, fundingAfter)
+
+				// the minted tokens are kept in the supply, the pools are only reconciled with the staked tokens.
+				require.Equal(supplyBefore.Sub(poolsBefore).Add(poolsAfter).String(), supplyAfter.String())
+			}
+
+			// delegations work as on any PoS chain.
+			stakingMsgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+			_, err = stakingMsgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(addrs[2].String(), val2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 7_000_000)))
+			require.NoError(err)
+
+			_, err = stakingMsgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(addrs[0].String(), val2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)))
+			require.NoError(err)
+
+			res := chain.nextBlock()
+			require.Len(res.ValidatorUpdates, 1)
+			require.EqualValues(10, res.ValidatorUpdates[0].Power)
+
+			msg, broken = stakingkeeper.AllInvariants(app.StakingKeeper)(chain.ctx())
+			require.False(broken, msg)
+		})
+	}
+}
+
+func TestPoAToPoSUpgradeHybridMode(t *testing.T) {
+	require := require.New(t)
+
+	chain := newUpgradeTestChain(t)
+	app := chain.app
+
+	ctx := chain.ctx()
+	poaMsgServer := poakeeper.NewMsgServerImpl(app.POAKeeper)
+	stakingMsgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	admin := app.POAKeeper.GetAdmin(ctx)
+	addrs := AddTestAddrsIncremental(app, ctx, 3, sdkmath.NewInt(100_000_000))
+
+	valAddr := sdk.ValAddress(addrs[0]).String()
+	msg, err := poa.NewMsgCreateValidator(
+		valAddr, ed25519.GenPrivKey().PubKey(),
+		poa.NewDescription("val", "", "", "", ""),
+		poa.NewCommissionRates(sdkmath.LegacyNewDecWithPrec(1, 1), sdkmath.LegacyNewDecWithPrec(2, 1), sdkmath.LegacyNewDecWithPrec(1, 2)),
+		sdkmath.OneInt(),
+	)
+	require.NoError(err)
+
+	_, err = poaMsgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	_, err = poaMsgServer.SetPower(ctx, &poa.MsgSetPower{Sender: admin, ValidatorAddress: valAddr, Power: 3_000_000, Unsafe: true})
+	require.NoError(err)
+
+	chain.nextBlock()
+	ctx = chain.ctx()
+
+	// a token holder delegates real tokens next to the POA self delegation.
+	params := poa.DefaultParams()
+	params.HybridMode = true
+	params.BlockedMsgTypeUrls = poa.HybridBlockedMsgTypeURLs()
+	_, err = poaMsgServer.UpdateParams(ctx, &poa.MsgUpdateParams{Sender: admin, Params: params})
+	require.NoError(err)
+
+	_, err = stakingMsgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(addrs[1].String(), valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 2_000_000)))
+	require.NoError(err)
+
+	chain.nextBlock()
+	ctx = chain.ctx()
+
+	fundingBefore := app.BankKeeper.GetBalance(ctx, addrs[2], sdk.DefaultBondDenom).Amount
+	opts := migrations.PoSOptions{FundingSource: addrs[2], Minter: minttypes.ModuleName}
+
+	var supplyBefore, supplyAfter sdkmath.Int
+	chain.upgrade("poa-to-pos", func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		supplyBefore = app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount
+
+		if err := migrations.MigrateToPoS(ctx, app.BankKeeper, app.StakingKeeper, opts); err != nil {
+			return nil, err
+		}
+
+		supplyAfter = app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount
+
+		return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+	})
+	ctx = chain.ctx()
+
+	msgInv, broken := stakingkeeper.AllInvariants(app.StakingKeeper)(ctx)
+	require.False(broken, msgInv)
+
+	// only the POA self delegation is replaced, the holder delegation was backed by real tokens.
+	selfTokens := sdkmath.NewInt(3_000_000)
+	fundingAfter := app.BankKeeper.GetBalance(ctx, addrs[2], sdk.DefaultBondDenom).Amount
+	require.Equal(selfTokens, fundingBefore.Sub(fundingAfter))
+	require.Equal(supplyBefore.Sub(selfTokens).String(), supplyAfter.String())
+
+	val, err := app.StakingKeeper.GetValidator(ctx, sdk.ValAddress(addrs[0]))
+	require.NoError(err)
+	require.EqualValues(5_000_000, val.Tokens.Int64())
+
+	del, err := app.StakingKeeper.GetDelegation(ctx, addrs[1], sdk.ValAddress(addrs[0]))
+	require.NoError(err)
+	require.EqualValues(2_000_000, val.TokensFromShares(del.Shares).TruncateInt().Int64())
+}
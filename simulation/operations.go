@@ -119,15 +119,10 @@ func SimulateMsgCreateValidator(txGen client.TxConfig, k keeper.Keeper) simtypes
 		description := generateRandomDescription(r)
 		commission := generateRandomCommission(r)
 
-		vals, err := k.PendingValidators.Get(ctx)
-		if err != nil {
+		isPending, err := k.IsValidatorPending(ctx, address.String())
+		if err != nil || isPending {
 			return simtypes.OperationMsg{}, nil, nil // nolint:nilerr
 		}
-		for _, v := range vals.Validators {
-			if v.OperatorAddress == address.String() {
-				return simtypes.OperationMsg{}, nil, nil
-			}
-		}
 
 		msg, err := poatypes.NewMsgCreateValidator(address.String(), simAccount.ConsKey.PubKey(), description, commission, selfDelegation.Amount)
 		if err != nil {
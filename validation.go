@@ -122,3 +122,58 @@ func (msg MsgSetPower) Validate(ac address.Codec) error {
 
 	return nil
 }
+
+func (msg MsgRegisterOrganization) Validate() error {
+	return msg.Organization.Validate()
+}
+
+func (msg MsgSetValidatorOrganization) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if len(msg.OrganizationId) > MaxOrganizationIDLength {
+		return errorsmod.Wrapf(ErrInvalidOrganization, "invalid id length; got: %d, max: %d", len(msg.OrganizationId), MaxOrganizationIDLength)
+	}
+
+	return nil
+}
+
+func (msg MsgSetMaintenanceMode) Validate() error {
+	if err := msg.MaintenanceMode.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+func (msg MsgUpdateAccountAllowlist) Validate(ac address.Codec) error {
+	if len(msg.AddAccounts)+len(msg.RemoveAccounts)+len(msg.AddPrefixes)+len(msg.RemovePrefixes) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty account allowlist update")
+	}
+
+	seen := make(map[string]bool)
+	for _, account := range append(append([]string{}, msg.AddAccounts...), msg.RemoveAccounts...) {
+		if _, err := ac.StringToBytes(account); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid account address %s: %s", account, err)
+		}
+
+		if seen[account] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is updated more than once", account)
+		}
+		seen[account] = true
+	}
+
+	for _, prefix := range append(append([]string{}, msg.AddPrefixes...), msg.RemovePrefixes...) {
+		if err := ValidateAddressPrefix(prefix); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		if seen[prefix] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "prefix %s is updated more than once", prefix)
+		}
+		seen[prefix] = true
+	}
+
+	return nil
+}
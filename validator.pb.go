@@ -384,7 +384,7 @@ func init() {
 }
 
 var fileDescriptor_239839702462c302 = []byte{
-	// 1031 bytes of a gzipped FileDescriptorProto
+	// 1027 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcf, 0x6b, 0x1b, 0x47,
 	0x14, 0xc7, 0xb5, 0x96, 0x2a, 0xcb, 0x23, 0xdb, 0x52, 0x26, 0x4e, 0xb3, 0x91, 0x41, 0x12, 0x0e,
 	0x24, 0xaa, 0x13, 0xaf, 0xb0, 0x0b, 0x3d, 0x98, 0x96, 0x62, 0x49, 0x6e, 0xa3, 0x36, 0x38, 0xee,
@@ -422,34 +422,34 @@ var fileDescriptor_239839702462c302 = []byte{
 	0xeb, 0x2f, 0x9f, 0xaf, 0x2d, 0x45, 0xd2, 0x6c, 0x85, 0x2b, 0x2d, 0xe1, 0x13, 0xea, 0x9a, 0xb9,
 	0x71, 0x47, 0x94, 0x86, 0xdf, 0x48, 0x31, 0x28, 0xc7, 0x94, 0x07, 0xdc, 0x1a, 0x04, 0x9d, 0x7d,
 	0x3c, 0x8c, 0x26, 0x5d, 0xba, 0x30, 0xe9, 0x16, 0x1d, 0xd6, 0xf4, 0x3f, 0x26, 0x68, 0xdb, 0x1f,
-	0x0e, 0x04, 0x33, 0x76, 0x83, 0xce, 0x97, 0x78, 0x28, 0xcf, 0x1f, 0x71, 0x76, 0x15, 0x06, 0xbe,
-	0x0f, 0xd2, 0x3f, 0x22, 0xd2, 0xc7, 0x8e, 0xba, 0xd3, 0x8c, 0x19, 0x45, 0xf0, 0x53, 0x90, 0xe6,
-	0x02, 0x89, 0x80, 0x2b, 0xa3, 0x2f, 0x6e, 0xdc, 0xbd, 0x54, 0xf5, 0x1a, 0xa3, 0x4e, 0x4b, 0x95,
-	0x9b, 0x51, 0x1b, 0xac, 0x83, 0xb4, 0x60, 0xfb, 0x98, 0x46, 0xef, 0xa0, 0x76, 0x2f, 0x32, 0xcb,
-	0x8d, 0x8b, 0x66, 0x69, 0x52, 0x31, 0x65, 0x93, 0x26, 0x15, 0x66, 0xd4, 0x0a, 0xbf, 0x07, 0x79,
-	0x07, 0xf7, 0xb1, 0xab, 0xe4, 0xe3, 0x3d, 0xe4, 0x63, 0xae, 0xa7, 0x15, 0x6e, 0xfd, 0xca, 0xde,
-	0x33, 0x73, 0x31, 0xaa, 0xa5, 0x48, 0xb0, 0x0d, 0xb2, 0xce, 0xe4, 0xab, 0xa1, 0xcf, 0x2a, 0x45,
-	0x2b, 0x97, 0x1e, 0x74, 0xea, 0x2b, 0x53, 0x9b, 0x93, 0x23, 0x84, 0x2e, 0x98, 0xe6, 0xc8, 0xaf,
-	0x45, 0x40, 0x3b, 0x8c, 0x3a, 0x84, 0xba, 0x56, 0x0f, 0x13, 0xb7, 0x27, 0xf4, 0x4c, 0x59, 0xab,
-	0x24, 0xcd, 0x5c, 0x9c, 0x7f, 0xa0, 0xd2, 0x70, 0x17, 0x2c, 0x4e, 0x4a, 0x95, 0x01, 0xe7, 0xae,
-	0x6a, 0xc0, 0x85, 0x18, 0x20, 0x4b, 0xa0, 0x09, 0xc0, 0xc4, 0xe2, 0x3a, 0x50, 0xb4, 0xbb, 0x6f,
-	0xf9, 0x62, 0xa6, 0x4f, 0x34, 0x45, 0x81, 0xdf, 0x81, 0xeb, 0x1e, 0xa1, 0x16, 0xc7, 0xfd, 0xae,
-	0x15, 0x69, 0x28, 0xe1, 0xd9, 0xab, 0xdf, 0xeb, 0x35, 0x8f, 0xd0, 0x16, 0xee, 0x77, 0x1b, 0x31,
-	0x05, 0x7e, 0x0c, 0x96, 0x27, 0x12, 0x30, 0x6a, 0xf5, 0x58, 0xdf, 0xb1, 0x7c, 0xdc, 0xb5, 0x6c,
-	0x16, 0x50, 0xa1, 0xcf, 0x2b, 0xe1, 0x6e, 0xc6, 0x25, 0x8f, 0xe8, 0x03, 0xd6, 0x77, 0x4c, 0xdc,
-	0xad, 0xcb, 0x65, 0x78, 0x1b, 0x4c, 0xce, 0x6f, 0x11, 0x87, 0xeb, 0x0b, 0xe5, 0x64, 0x25, 0x65,
-	0xce, 0xc7, 0xc9, 0xa6, 0xc3, 0x37, 0x33, 0x8f, 0x8f, 0x4b, 0x89, 0xd7, 0xc7, 0xa5, 0xc4, 0xea,
-	0x6f, 0x1a, 0x00, 0x13, 0xaf, 0xc2, 0xfb, 0xe0, 0x66, 0xed, 0xd1, 0x4e, 0xc3, 0x6a, 0xed, 0x6d,
-	0xed, 0xb5, 0x5b, 0x56, 0x7b, 0xa7, 0xb5, 0xbb, 0x5d, 0x6f, 0x7e, 0xd6, 0xdc, 0x6e, 0xe4, 0x13,
-	0x85, 0xdc, 0xe8, 0xa8, 0x9c, 0x6d, 0x53, 0x3e, 0xc0, 0x36, 0xe9, 0x12, 0xec, 0xc0, 0x3b, 0x60,
-	0xe9, 0xbf, 0xd5, 0x32, 0xda, 0x6e, 0xe4, 0xb5, 0xc2, 0xfc, 0xe8, 0xa8, 0x9c, 0x69, 0xab, 0x2d,
-	0xb1, 0x03, 0x2b, 0xe0, 0xc6, 0xc5, 0xba, 0xe6, 0xce, 0xe7, 0xf9, 0x99, 0xc2, 0xc2, 0xe8, 0xa8,
-	0x3c, 0xd7, 0x1e, 0xcf, 0x06, 0x57, 0x00, 0x9c, 0xae, 0x8c, 0x78, 0xc9, 0x02, 0x18, 0x1d, 0x95,
-	0xd3, 0x35, 0x45, 0x2b, 0xa4, 0x1e, 0xff, 0x5a, 0x4c, 0xd4, 0x3e, 0x79, 0x71, 0x56, 0xd4, 0x4e,
-	0xce, 0x8a, 0xda, 0x3f, 0x67, 0x45, 0xed, 0xc9, 0x79, 0x31, 0x71, 0x72, 0x5e, 0x4c, 0xfc, 0x79,
-	0x5e, 0x4c, 0x7c, 0x7b, 0xdb, 0x25, 0xa2, 0x17, 0x74, 0x0c, 0x9b, 0x79, 0xd5, 0xa9, 0x6b, 0x5e,
-	0x9b, 0xfe, 0x0f, 0xa2, 0x93, 0x56, 0x3e, 0xfa, 0xf0, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x15,
-	0xb2, 0x79, 0x0b, 0x64, 0x08, 0x00, 0x00,
+	0x0e, 0x04, 0x33, 0x76, 0x83, 0xce, 0x97, 0x78, 0x68, 0xe6, 0x62, 0xce, 0xae, 0xc2, 0xc0, 0xf7,
+	0x41, 0xfa, 0x47, 0x44, 0xfa, 0xd8, 0x51, 0x77, 0x9a, 0x31, 0xa3, 0x08, 0x7e, 0x0a, 0xd2, 0x5c,
+	0x20, 0x11, 0x70, 0x65, 0xf4, 0xc5, 0x8d, 0xbb, 0x97, 0xaa, 0x5e, 0x63, 0xd4, 0x69, 0xa9, 0x72,
+	0x33, 0x6a, 0x83, 0x75, 0x90, 0x16, 0x6c, 0x1f, 0xd3, 0xe8, 0x1d, 0xd4, 0xee, 0x45, 0x66, 0xb9,
+	0x71, 0xd1, 0x2c, 0x4d, 0x2a, 0xa6, 0x6c, 0xd2, 0xa4, 0xc2, 0x8c, 0x5a, 0xe1, 0xf7, 0x20, 0xef,
+	0xe0, 0x3e, 0x76, 0x95, 0x7c, 0xbc, 0x87, 0x7c, 0xcc, 0xf5, 0xb4, 0xc2, 0xad, 0x5f, 0xd9, 0x7b,
+	0x66, 0x2e, 0x46, 0xb5, 0x14, 0x09, 0xb6, 0x41, 0xd6, 0x99, 0x7c, 0x35, 0xf4, 0x59, 0xa5, 0x68,
+	0xe5, 0xd2, 0x83, 0x4e, 0x7d, 0x65, 0x6a, 0x73, 0x72, 0x84, 0xd0, 0x05, 0xd3, 0x1c, 0xf9, 0xb5,
+	0x08, 0x68, 0x87, 0x51, 0x87, 0x50, 0xd7, 0xea, 0x61, 0xe2, 0xf6, 0x84, 0x9e, 0x29, 0x6b, 0x95,
+	0xa4, 0x99, 0x8b, 0xf3, 0x0f, 0x54, 0x1a, 0xee, 0x82, 0xc5, 0x49, 0xa9, 0x32, 0xe0, 0xdc, 0x55,
+	0x0d, 0xb8, 0x10, 0x03, 0x64, 0x09, 0x34, 0x01, 0x98, 0x58, 0x5c, 0x07, 0x8a, 0x76, 0xf7, 0x2d,
+	0x5f, 0xcc, 0xf4, 0x89, 0xa6, 0x28, 0xf0, 0x3b, 0x70, 0xdd, 0x23, 0xd4, 0xe2, 0xb8, 0xdf, 0xb5,
+	0x22, 0x0d, 0x25, 0x3c, 0x7b, 0xf5, 0x7b, 0xbd, 0xe6, 0x11, 0xda, 0xc2, 0xfd, 0x6e, 0x23, 0xa6,
+	0xc0, 0x8f, 0xc1, 0xf2, 0x44, 0x02, 0x46, 0xad, 0x1e, 0xeb, 0x3b, 0x96, 0x8f, 0xbb, 0x96, 0xcd,
+	0x02, 0x2a, 0xf4, 0x79, 0x25, 0xdc, 0xcd, 0xb8, 0xe4, 0x11, 0x7d, 0xc0, 0xfa, 0x8e, 0x89, 0xbb,
+	0x75, 0xb9, 0x0c, 0x6f, 0x83, 0xc9, 0xf9, 0x2d, 0xe2, 0x70, 0x7d, 0xa1, 0x9c, 0xac, 0xa4, 0xcc,
+	0xf9, 0x38, 0xd9, 0x74, 0xf8, 0x66, 0xe6, 0xf1, 0x71, 0x29, 0xf1, 0xfa, 0xb8, 0x94, 0x58, 0xfd,
+	0x4d, 0x03, 0x60, 0xe2, 0x55, 0x78, 0x1f, 0xdc, 0xac, 0x3d, 0xda, 0x69, 0x58, 0xad, 0xbd, 0xad,
+	0xbd, 0x76, 0xcb, 0x6a, 0xef, 0xb4, 0x76, 0xb7, 0xeb, 0xcd, 0xcf, 0x9a, 0xdb, 0x8d, 0x7c, 0xa2,
+	0x90, 0x1b, 0x1d, 0x95, 0xb3, 0x6d, 0xca, 0x07, 0xd8, 0x26, 0x5d, 0x82, 0x1d, 0x78, 0x07, 0x2c,
+	0xfd, 0xb7, 0x5a, 0x46, 0xdb, 0x8d, 0xbc, 0x56, 0x98, 0x1f, 0x1d, 0x95, 0x33, 0x6d, 0xb5, 0x25,
+	0x76, 0x60, 0x05, 0xdc, 0xb8, 0x58, 0xd7, 0xdc, 0xf9, 0x3c, 0x3f, 0x53, 0x58, 0x18, 0x1d, 0x95,
+	0xe7, 0xda, 0xe3, 0xd9, 0xe0, 0x0a, 0x80, 0xd3, 0x95, 0x11, 0x2f, 0x59, 0x00, 0xa3, 0xa3, 0x72,
+	0xba, 0xa6, 0x68, 0x85, 0xd4, 0xe3, 0x5f, 0x8b, 0x89, 0xda, 0x27, 0x2f, 0xce, 0x8a, 0xda, 0xc9,
+	0x59, 0x51, 0xfb, 0xe7, 0xac, 0xa8, 0x3d, 0x39, 0x2f, 0x26, 0x4e, 0xce, 0x8b, 0x89, 0x3f, 0xcf,
+	0x8b, 0x89, 0x6f, 0x6f, 0xbb, 0x44, 0xf4, 0x82, 0x8e, 0x61, 0x33, 0xaf, 0x3a, 0x75, 0xcd, 0x6b,
+	0xd3, 0xff, 0x41, 0x74, 0xd2, 0xca, 0x47, 0x1f, 0xfe, 0x3b, 0x00, 0x15, 0xb2, 0x79, 0x0b, 0x64,
+	0x08, 0x00, 0x00,
 }
 
 func (this *Description) Equal(that interface{}) bool {